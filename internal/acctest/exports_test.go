@@ -1,6 +1,35 @@
 package acctest
 
+import (
+	"context"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
 )
+
+func VCRMatcher(ctx context.Context) cassette.MatcherFunc {
+	return vcrMatcher(ctx, vcrIgnoredFields)
+}
+
+// RedactVCRInteractions redacts interactions as if they had been recorded and then saved to a cassette.
+func RedactVCRInteractions(interactions ...*cassette.Interaction) error {
+	redactor := newVCRRedactor()
+
+	for _, i := range interactions {
+		if err := redactor.afterCapture(i); err != nil {
+			return err
+		}
+	}
+
+	for _, i := range interactions {
+		if err := redactor.beforeSave(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
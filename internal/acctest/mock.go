@@ -0,0 +1,382 @@
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Mock AWS API endpoints.
+//
+// A MockServer is an in-process HTTP stub for a single AWS service.
+// Requests are resolved to an API operation name and are answered, in order of precedence, by
+//   - the first queued fixture registered for the operation, which is then discarded
+//   - the handler registered for the operation
+//   - the default handler
+//
+// Requests that are not answered by any of these receive a non-retryable error.
+// Use ConfigMockProvider to route the provider's API requests to mock servers
+// so that resource.UnitTest test cases can run entirely offline, without AWS credentials.
+
+const (
+	// MockAccountID is the AWS account ID used in mock responses.
+	MockAccountID = "123456789012"
+	// MockRegion is the AWS Region configured by ConfigMockProvider.
+	MockRegion = "us-west-2" //lintignore:AWSAT003
+
+	mockContentTypeJSON = "application/x-amz-json-1.1"
+	mockContentTypeXML  = "text/xml"
+)
+
+// MockRequest is an AWS API request received by a MockServer.
+type MockRequest struct {
+	// Operation is the API operation name, e.g. "PutParameter".
+	// For JSON protocols it is taken from the X-Amz-Target header and for the Query and EC2 protocols from the Action parameter.
+	// For REST protocols it is the HTTP method and URL path, e.g. "GET /2015-03-31/functions/example".
+	Operation string
+	Method    string
+	Path      string
+	Header    http.Header
+	// Params contains the URL query string and any form-encoded request body parameters.
+	Params url.Values
+	Body   []byte
+}
+
+// DecodeJSON unmarshals the request's JSON body into v.
+func (r *MockRequest) DecodeJSON(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// MockResponse is a canned AWS API response.
+type MockResponse struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"headers"`
+	Body       string            `json:"body"`
+}
+
+// MockJSONResponse returns a successful JSON protocol response with v marshaled as the body.
+func MockJSONResponse(v any) *MockResponse {
+	body, err := json.Marshal(v)
+
+	if err != nil {
+		return MockJSONErrorResponse(http.StatusInternalServerError, "InternalFailure", err.Error())
+	}
+
+	return &MockResponse{
+		StatusCode: http.StatusOK,
+		Header:     map[string]string{"Content-Type": mockContentTypeJSON},
+		Body:       string(body),
+	}
+}
+
+// MockJSONErrorResponse returns a JSON protocol error response.
+func MockJSONErrorResponse(statusCode int, code, message string) *MockResponse {
+	return &MockResponse{
+		StatusCode: statusCode,
+		Header:     map[string]string{"Content-Type": mockContentTypeJSON},
+		Body:       fmt.Sprintf(`{"__type":%q,"message":%q}`, code, message),
+	}
+}
+
+// MockXMLResponse returns a successful XML (Query or REST-XML protocol) response.
+func MockXMLResponse(body string) *MockResponse {
+	return &MockResponse{
+		StatusCode: http.StatusOK,
+		Header:     map[string]string{"Content-Type": mockContentTypeXML},
+		Body:       body,
+	}
+}
+
+// MockQueryResponse returns a successful Query protocol response for the specified operation.
+// result is the XML content of the <operation>Result element.
+func MockQueryResponse(operation, result string) *MockResponse {
+	return MockXMLResponse(fmt.Sprintf(`<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>00000000-0000-0000-0000-000000000000</RequestId></ResponseMetadata></%[1]sResponse>`, operation, result))
+}
+
+// MockQueryErrorResponse returns a Query protocol error response.
+func MockQueryErrorResponse(statusCode int, code, message string) *MockResponse {
+	return &MockResponse{
+		StatusCode: statusCode,
+		Header:     map[string]string{"Content-Type": mockContentTypeXML},
+		Body:       fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>00000000-0000-0000-0000-000000000000</RequestId></ErrorResponse>`, code, html.EscapeString(message)),
+	}
+}
+
+// MockHandlerFunc programmatically answers a mock AWS API request.
+// Returning nil passes the request on to the next handler in order of precedence.
+type MockHandlerFunc func(*MockRequest) *MockResponse
+
+// MockFixture is a declarative, single use response to an AWS API operation.
+type MockFixture struct {
+	Operation string       `json:"operation"`
+	Response  MockResponse `json:"response"`
+}
+
+// MockServer is an in-process HTTP stub for an AWS service endpoint.
+type MockServer struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	defaultHandler MockHandlerFunc
+	fixtures       map[string][]MockResponse
+	handlers       map[string]MockHandlerFunc
+	requests       []*MockRequest
+}
+
+// NewMockServer starts a MockServer which is closed when the test and all its subtests complete.
+func NewMockServer(t *testing.T) *MockServer {
+	t.Helper()
+
+	s := &MockServer{
+		fixtures: make(map[string][]MockResponse),
+		handlers: make(map[string]MockHandlerFunc),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// AddFixtures queues fixtures. Fixtures for the same operation are used in the order they are added.
+func (s *MockServer) AddFixtures(fixtures ...MockFixture) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, fixture := range fixtures {
+		s.fixtures[fixture.Operation] = append(s.fixtures[fixture.Operation], fixture.Response)
+	}
+}
+
+// LoadFixtures queues the fixtures from a JSON file containing an array of MockFixture.
+func (s *MockServer) LoadFixtures(t *testing.T, path string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("reading mock fixtures (%s): %s", path, err)
+	}
+
+	var fixtures []MockFixture
+
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatalf("parsing mock fixtures (%s): %s", path, err)
+	}
+
+	s.AddFixtures(fixtures...)
+}
+
+// HandleOperation registers the handler for the specified operation.
+func (s *MockServer) HandleOperation(operation string, handler MockHandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[operation] = handler
+}
+
+// HandleDefault registers the handler for requests not answered by a fixture or operation handler.
+func (s *MockServer) HandleDefault(handler MockHandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.defaultHandler = handler
+}
+
+// Requests returns the requests received for the specified operation, or all requests if operation is empty.
+func (s *MockServer) Requests(operation string) []*MockRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []*MockRequest
+
+	for _, request := range s.requests {
+		if operation == "" || request.Operation == operation {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+func (s *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	request, err := newMockRequest(r)
+
+	if err != nil {
+		writeMockResponse(w, MockJSONErrorResponse(http.StatusBadRequest, "SerializationException", err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, request)
+	var response *MockResponse
+	if fixtures := s.fixtures[request.Operation]; len(fixtures) > 0 {
+		response = &fixtures[0]
+		s.fixtures[request.Operation] = fixtures[1:]
+	}
+	handler, defaultHandler := s.handlers[request.Operation], s.defaultHandler
+	s.mu.Unlock()
+
+	// Handlers are called without the lock held so that they can register fixtures or inspect requests.
+	if response == nil && handler != nil {
+		response = handler(request)
+	}
+
+	if response == nil && defaultHandler != nil {
+		response = defaultHandler(request)
+	}
+
+	if response == nil {
+		// Respond with a client error so that the AWS SDK does not retry.
+		message := fmt.Sprintf("no mock response for operation %q", request.Operation)
+		if strings.Contains(r.Header.Get("Content-Type"), "json") {
+			response = MockJSONErrorResponse(http.StatusBadRequest, "MockNotImplemented", message)
+		} else {
+			response = MockQueryErrorResponse(http.StatusBadRequest, "MockNotImplemented", message)
+		}
+	}
+
+	writeMockResponse(w, response)
+}
+
+func newMockRequest(r *http.Request) (*MockRequest, error) {
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := r.URL.Query()
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range form {
+			params[k] = append(params[k], v...)
+		}
+	}
+
+	request := &MockRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Header: r.Header,
+		Params: params,
+		Body:   body,
+	}
+
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		request.Operation = v[strings.LastIndex(v, ".")+1:]
+	} else if v := params.Get("Action"); v != "" {
+		request.Operation = v
+	} else {
+		request.Operation = r.Method + " " + r.URL.Path
+	}
+
+	return request, nil
+}
+
+func writeMockResponse(w http.ResponseWriter, response *MockResponse) {
+	for k, v := range response.Header {
+		w.Header().Set(k, v)
+	}
+
+	statusCode := response.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.WriteHeader(statusCode)
+	w.Write([]byte(response.Body)) //nolint:errcheck
+}
+
+// ConfigMockProvider returns a provider configuration that sends the API requests of the specified services
+// to mock endpoints, keyed by the `endpoints` block argument name (e.g. "ssm"), and that needs no AWS credentials.
+func ConfigMockProvider(endpoints map[string]string) string {
+	services := make([]string, 0, len(endpoints))
+	for service := range endpoints {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	var str strings.Builder
+
+	for _, service := range services {
+		fmt.Fprintf(&str, "    %s = %q\n", service, endpoints[service])
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key = "mock_access_key"
+  secret_key = "mock_secret_key"
+  region     = %[1]q
+
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+
+  endpoints {
+%[2]s  }
+}
+`, MockRegion, str.String())
+}
+
+// MockUnitTest wraps resource.UnitTest, configuring the provider to send the API requests of the specified services
+// to mock endpoints. The mock provider configuration is prepended to the configuration of each test step.
+// The test is skipped if no Terraform CLI binary is available, so that unit test runs never download one.
+func MockUnitTest(t *testing.T, endpoints map[string]string, c resource.TestCase) {
+	t.Helper()
+
+	PreCheckTerraformCLI(t)
+
+	if c.ProtoV5ProviderFactories == nil {
+		c.ProtoV5ProviderFactories = ProtoV5ProviderFactories
+	}
+
+	providerConfig := ConfigMockProvider(endpoints)
+
+	steps := make([]resource.TestStep, len(c.Steps))
+	for i, step := range c.Steps {
+		if step.Config != "" {
+			step.Config = ConfigCompose(providerConfig, step.Config)
+		}
+		steps[i] = step
+	}
+	c.Steps = steps
+
+	resource.UnitTest(t, c)
+}
+
+// PreCheckTerraformCLI skips the test if no Terraform CLI binary is found
+// via the TF_ACC_TERRAFORM_PATH environment variable or in the PATH.
+func PreCheckTerraformCLI(t *testing.T) {
+	t.Helper()
+
+	if v := os.Getenv("TF_ACC_TERRAFORM_PATH"); v != "" {
+		if _, err := os.Stat(v); err != nil {
+			t.Skipf("skipping test; Terraform CLI binary not found at TF_ACC_TERRAFORM_PATH (%s): %s", v, err)
+		}
+
+		return
+	}
+
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("skipping test; Terraform CLI binary not found in PATH, set TF_ACC_TERRAFORM_PATH to run mock endpoint tests")
+	}
+}
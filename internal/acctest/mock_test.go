@@ -0,0 +1,183 @@
+package acctest_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestMockServer(t *testing.T) {
+	t.Parallel()
+
+	server := acctest.NewMockServer(t)
+	server.AddFixtures(
+		acctest.MockFixture{
+			Operation: "GetParameter",
+			Response:  *acctest.MockJSONErrorResponse(http.StatusBadRequest, "ParameterNotFound", "not found"),
+		},
+		acctest.MockFixture{
+			Operation: "GetParameter",
+			Response:  *acctest.MockJSONResponse(map[string]any{"Parameter": map[string]any{"Name": "fixture"}}),
+		},
+	)
+	server.HandleOperation("GetParameter", func(r *acctest.MockRequest) *acctest.MockResponse {
+		return acctest.MockJSONResponse(map[string]any{"Parameter": map[string]any{"Name": "handler"}})
+	})
+	server.HandleDefault(func(r *acctest.MockRequest) *acctest.MockResponse {
+		if r.Operation != "GET /2015-03-31/functions/example" {
+			return nil
+		}
+
+		return acctest.MockJSONResponse(map[string]any{"FunctionName": "example"})
+	})
+
+	doJSON := func(target string) (int, string) {
+		request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"Name":"example"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		request.Header.Set("X-Amz-Target", target)
+
+		return doMockRequest(t, request)
+	}
+
+	doQuery := func(action string) (int, string) {
+		request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(url.Values{"Action": {action}, "QueueName": {"example"}}.Encode()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+		return doMockRequest(t, request)
+	}
+
+	doREST := func(path string) (int, string) {
+		request, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return doMockRequest(t, request)
+	}
+
+	testCases := []struct {
+		name           string
+		do             func() (int, string)
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "first fixture",
+			do:             func() (int, string) { return doJSON("AmazonSSM.GetParameter") },
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "ParameterNotFound",
+		},
+		{
+			name:           "second fixture",
+			do:             func() (int, string) { return doJSON("AmazonSSM.GetParameter") },
+			wantStatusCode: http.StatusOK,
+			wantBody:       `"Name":"fixture"`,
+		},
+		{
+			name:           "operation handler",
+			do:             func() (int, string) { return doJSON("AmazonSSM.GetParameter") },
+			wantStatusCode: http.StatusOK,
+			wantBody:       `"Name":"handler"`,
+		},
+		{
+			name:           "default handler",
+			do:             func() (int, string) { return doREST("/2015-03-31/functions/example") },
+			wantStatusCode: http.StatusOK,
+			wantBody:       `"FunctionName":"example"`,
+		},
+		{
+			name:           "JSON protocol not implemented",
+			do:             func() (int, string) { return doJSON("AmazonSSM.DeleteParameter") },
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `"__type":"MockNotImplemented"`,
+		},
+		{
+			name:           "Query protocol not implemented",
+			do:             func() (int, string) { return doQuery("CreateQueue") },
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "<Code>MockNotImplemented</Code>",
+		},
+	}
+
+	// Fixtures are consumed, so test cases run sequentially.
+	for _, testCase := range testCases {
+		gotStatusCode, gotBody := testCase.do()
+
+		if gotStatusCode != testCase.wantStatusCode {
+			t.Errorf("%s: got status code %d, expected %d", testCase.name, gotStatusCode, testCase.wantStatusCode)
+		}
+
+		if !strings.Contains(gotBody, testCase.wantBody) {
+			t.Errorf("%s: got body %q, expected to contain %q", testCase.name, gotBody, testCase.wantBody)
+		}
+	}
+
+	if got, want := len(server.Requests("GetParameter")), 3; got != want {
+		t.Errorf("got %d GetParameter requests, expected %d", got, want)
+	}
+
+	requests := server.Requests("CreateQueue")
+
+	if got, want := len(requests), 1; got != want {
+		t.Fatalf("got %d CreateQueue requests, expected %d", got, want)
+	}
+
+	if got, want := requests[0].Params.Get("QueueName"), "example"; got != want {
+		t.Errorf("got CreateQueue QueueName %q, expected %q", got, want)
+	}
+
+	if got, want := len(server.Requests("")), len(testCases); got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+}
+
+func TestConfigMockProvider(t *testing.T) {
+	t.Parallel()
+
+	config := acctest.ConfigMockProvider(map[string]string{
+		"sqs": "http://127.0.0.1:1234",
+		"ssm": "http://127.0.0.1:5678",
+	})
+
+	for _, want := range []string{
+		`sqs = "http://127.0.0.1:1234"`,
+		`ssm = "http://127.0.0.1:5678"`,
+		"skip_credentials_validation = true",
+		"skip_requesting_account_id  = true",
+	} {
+		if !strings.Contains(config, want) {
+			t.Errorf("expected configuration to contain %q, got:\n%s", want, config)
+		}
+	}
+
+	if strings.Index(config, "sqs =") > strings.Index(config, "ssm =") {
+		t.Errorf("expected endpoints to be sorted, got:\n%s", config)
+	}
+}
+
+func doMockRequest(t *testing.T, request *http.Request) (int, string) {
+	t.Helper()
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.StatusCode, string(body)
+}
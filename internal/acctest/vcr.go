@@ -4,30 +4,25 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/request"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
@@ -168,90 +163,19 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, diag.FromErr(err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
+		// Redact sensitive data before the cassette is saved.
+		redactor := newVCRRedactor()
+		r.AddHook(redactor.afterCapture, recorder.AfterCaptureHook)
+		r.AddHook(redactor.beforeSave, recorder.BeforeSaveHook)
 
 		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
+		r.SetMatcher(vcrMatcher(ctx, vcrIgnoredFields))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		// The same HTTP client is used by both AWS SDK for Go v1 and v2 API clients.
+		httpClient.Transport = &vcrTransport{recorder: r}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -266,23 +190,62 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		meta.LogsConn().Handlers.AfterRetry.PushFront(func(r *request.Request) {
-			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-				r.Retryable = aws.Bool(false)
-			}
-		})
-
 		providerMetas[testName] = meta
 
 		return meta, nil
 	}
 }
 
+// vcrTransport is an http.RoundTripper that wraps a VCR recorder.
+// A request with no recorded interaction gets a client error response rather than a transport error,
+// so that neither AWS SDK for Go v1 nor v2 API clients retry the request.
+type vcrTransport struct {
+	recorder *recorder.Recorder
+}
+
+func (t *vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	response, err := t.recorder.RoundTrip(r)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return newVCRInteractionNotFoundResponse(r), nil
+	}
+
+	return response, err
+}
+
+// newVCRInteractionNotFoundResponse returns a non-retryable error response in the request's protocol.
+func newVCRInteractionNotFoundResponse(r *http.Request) *http.Response {
+	const code = "VCRInteractionNotFound"
+	message := fmt.Sprintf("%s: %s %s", cassette.ErrInteractionNotFound, r.Method, r.URL)
+
+	var contentType, body string
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		contentType = "application/json"
+		body = fmt.Sprintf(`{"__type":%q,"message":%q}`, code, message)
+	} else {
+		var b strings.Builder
+		xml.EscapeText(&b, []byte(message)) //nolint:errcheck
+		contentType = "text/xml"
+		body = fmt.Sprintf(`<ErrorResponse><Error><Code>%s</Code><Message>%s</Message></Error></ErrorResponse>`, code, b.String())
+	}
+
+	header := make(http.Header)
+	header.Set("Content-Type", contentType)
+	header.Set("X-Amzn-ErrorType", code)
+
+	return &http.Response{
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Header:        header,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       r,
+		Status:        fmt.Sprintf("%d %s", http.StatusBadRequest, http.StatusText(http.StatusBadRequest)),
+		StatusCode:    http.StatusBadRequest,
+	}
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -387,9 +350,9 @@ func closeVCRRecorder(t *testing.T) {
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient().Transport.(*recorder.Recorder); ok {
+			if v, ok := meta.HTTPClient().Transport.(*vcrTransport); ok {
 				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
+				if err := v.recorder.Stop(); err != nil {
 					t.Error(err)
 				}
 			}
@@ -0,0 +1,288 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// vcrIgnoredFields are JSON object keys, XML element names and query string or form parameter names
+// whose values change between test runs and are ignored when matching requests to recorded interactions.
+var vcrIgnoredFields = map[string]struct{}{
+	"CallerReference":    {},
+	"ClientRequestToken": {},
+	"ClientToken":        {},
+	"IdempotencyToken":   {},
+}
+
+// RegisterVCRIgnoredFields registers additional volatile fields that are ignored when matching requests to recorded interactions.
+// It is typically called from a service test package's init function.
+func RegisterVCRIgnoredFields(fields ...string) {
+	for _, field := range fields {
+		vcrIgnoredFields[field] = struct{}{}
+	}
+}
+
+// vcrFieldIgnored returns whether the specified field, or the last component of a Query protocol parameter name (e.g. "Filter.1.ClientToken"), is ignored.
+func vcrFieldIgnored(ignored map[string]struct{}, name string) bool {
+	if _, ok := ignored[name]; ok {
+		return true
+	}
+
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		_, ok := ignored[name[i+1:]]
+		return ok
+	}
+
+	return false
+}
+
+// vcrMatcher returns a function that matches requests to recorded interactions.
+// Requests match if the method, URL (ignoring query parameter order) and body (ignoring formatting and, for JSON, field order) are the same,
+// disregarding the values of ignored fields.
+func vcrMatcher(ctx context.Context, ignored map[string]struct{}) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !vcrURLsMatch(r.URL, i.URL, ignored) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		match, err := vcrBodiesMatch(r.Header.Get("Content-Type"), body, i.Body, ignored)
+
+		if err != nil {
+			tflog.Debug(ctx, "Failed to compare request body with cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		return match
+	}
+}
+
+func vcrURLsMatch(u *url.URL, recorded string, ignored map[string]struct{}) bool {
+	v, err := url.Parse(recorded)
+
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != v.Scheme || u.Host != v.Host || u.Path != v.Path {
+		return false
+	}
+
+	if u.RawQuery == v.RawQuery {
+		return true
+	}
+
+	return vcrValuesEqual(u.Query(), v.Query(), ignored)
+}
+
+// vcrValuesEqual compares query string or form parameters, ignoring order.
+func vcrValuesEqual(a, b url.Values, ignored map[string]struct{}) bool {
+	filter := func(values url.Values) url.Values {
+		filtered := make(url.Values, len(values))
+
+		for k, v := range values {
+			if !vcrFieldIgnored(ignored, k) {
+				filtered[k] = v
+			}
+		}
+
+		return filtered
+	}
+
+	return reflect.DeepEqual(filter(a), filter(b))
+}
+
+// vcrBodiesMatch compares a request body with a recorded request body according to the request's content type.
+// See https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+func vcrBodiesMatch(contentType, body, recorded string, ignored map[string]struct{}) (bool, error) {
+	if contentType == "" {
+		return false, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return false, err
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered.
+		a, err := decodeVCRJSON(body, ignored)
+
+		if err != nil {
+			return false, err
+		}
+
+		b, err := decodeVCRJSON(recorded, ignored)
+
+		if err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(a, b), nil
+
+	case "application/x-www-form-urlencoded":
+		// Query and EC2 protocol parameters might be the same, but reordered.
+		a, err := url.ParseQuery(body)
+
+		if err != nil {
+			return false, err
+		}
+
+		b, err := url.ParseQuery(recorded)
+
+		if err != nil {
+			return false, err
+		}
+
+		return vcrValuesEqual(a, b, ignored), nil
+
+	case "application/xml", "text/xml":
+		// XML might be the same, but formatted differently.
+		a, err := decodeVCRXML(body, ignored)
+
+		if err != nil {
+			return false, err
+		}
+
+		b, err := decodeVCRXML(recorded, ignored)
+
+		if err != nil {
+			return false, err
+		}
+
+		return reflect.DeepEqual(a, b), nil
+	}
+
+	return false, nil
+}
+
+// decodeVCRJSON decodes a JSON document, removing ignored object keys at any depth.
+func decodeVCRJSON(s string, ignored map[string]struct{}) (any, error) {
+	var v any
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var filter func(any)
+	filter = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, e := range v {
+				if vcrFieldIgnored(ignored, k) {
+					delete(v, k)
+					continue
+				}
+
+				filter(e)
+			}
+		case []any:
+			for _, e := range v {
+				filter(e)
+			}
+		}
+	}
+	filter(v)
+
+	return v, nil
+}
+
+// vcrXMLNode is a generic XML element.
+type vcrXMLNode struct {
+	Name     xml.Name
+	Attrs    []xml.Attr
+	Text     string
+	Children []*vcrXMLNode
+}
+
+// decodeVCRXML decodes an XML document, removing ignored elements at any depth.
+// Whitespace surrounding character data is removed and attributes are sorted.
+func decodeVCRXML(s string, ignored map[string]struct{}) (*vcrXMLNode, error) {
+	root := &vcrXMLNode{}
+	stack := []*vcrXMLNode{root}
+	decoder := xml.NewDecoder(strings.NewReader(s))
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			attrs := make([]xml.Attr, len(token.Attr))
+			copy(attrs, token.Attr)
+			sort.Slice(attrs, func(i, j int) bool {
+				if attrs[i].Name.Space != attrs[j].Name.Space {
+					return attrs[i].Name.Space < attrs[j].Name.Space
+				}
+				return attrs[i].Name.Local < attrs[j].Name.Local
+			})
+
+			stack = append(stack, &vcrXMLNode{
+				Name:  token.Name,
+				Attrs: attrs,
+			})
+		case xml.EndElement:
+			node := parent
+			stack = stack[:len(stack)-1]
+
+			if !vcrFieldIgnored(ignored, node.Name.Local) {
+				stack[len(stack)-1].Children = append(stack[len(stack)-1].Children, node)
+			}
+		case xml.CharData:
+			parent.Text += strings.TrimSpace(string(token))
+		}
+	}
+
+	return root, nil
+}
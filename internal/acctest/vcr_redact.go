@@ -0,0 +1,260 @@
+package acctest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// VCRHookFunc modifies a recorded interaction before the cassette is saved, e.g. to redact sensitive data.
+// Hooks run only when the cassette is saved so that the provider sees unmodified responses while recording.
+type VCRHookFunc func(*cassette.Interaction) error
+
+var vcrHooks []VCRHookFunc
+
+// RegisterVCRHook registers a hook that is applied to all recorded interactions.
+// It is typically called from a service test package's init function to redact service-specific secrets.
+func RegisterVCRHook(hook VCRHookFunc) {
+	vcrHooks = append(vcrHooks, hook)
+}
+
+var (
+	// vcrRedactedHeaders are HTTP headers removed from recorded requests.
+	vcrRedactedHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// vcrKMSCiphertextFields are JSON fields containing KMS ciphertext.
+	vcrKMSCiphertextFields = []string{
+		"CiphertextBlob",
+		"CiphertextForRecipient",
+	}
+
+	// vcrAccountIDRegexps match AWS account IDs (the first submatch) in ARNs, including URL-encoded ARNs, and in STS caller identities.
+	vcrAccountIDRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)arn(?::|%3A)aws[a-z-]*(?::|%3A)[a-z0-9-]*(?::|%3A)[a-z0-9-]*(?::|%3A)(\d{12})(?::|%3A)`),
+		regexp.MustCompile(`<Account>(\d{12})</Account>`),
+		regexp.MustCompile(`"Account"\s*:\s*"(\d{12})"`),
+	}
+)
+
+const (
+	// vcrRedactedAccountIDBase is the first placeholder AWS account ID used in redacted cassettes.
+	vcrRedactedAccountIDBase = 123456789012
+	// vcrRedactedValuePrefix prefixes the (base64-encoded) placeholders of redacted values.
+	// Its length is a multiple of 3 so that its base64 encoding is a prefix of every placeholder's.
+	vcrRedactedValuePrefix = "REDACTED-"
+)
+
+var vcrRedactedValuePrefixBase64 = base64.StdEncoding.EncodeToString([]byte(vcrRedactedValuePrefix))
+
+// vcrRedactor redacts sensitive data from recorded interactions.
+type vcrRedactor struct {
+	// accountIDs maps each AWS account ID seen while recording to its placeholder.
+	accountIDs map[string]string
+}
+
+func newVCRRedactor() *vcrRedactor {
+	return &vcrRedactor{
+		accountIDs: make(map[string]string),
+	}
+}
+
+// afterCapture is run as each interaction is recorded.
+// AWS account IDs are discovered as interactions are recorded so that all occurrences can be redacted when the cassette is saved,
+// including those in interactions recorded before the account ID first appeared in an ARN.
+func (r *vcrRedactor) afterCapture(i *cassette.Interaction) error {
+	for _, s := range []string{i.Request.URL, i.Request.Body, i.Response.Body} {
+		for _, re := range vcrAccountIDRegexps {
+			for _, match := range re.FindAllStringSubmatch(s, -1) {
+				accountID := match[1]
+
+				if _, ok := r.accountIDs[accountID]; !ok {
+					r.accountIDs[accountID] = strconv.Itoa(vcrRedactedAccountIDBase + len(r.accountIDs))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// beforeSave is run on each interaction before the cassette is saved.
+func (r *vcrRedactor) beforeSave(i *cassette.Interaction) error {
+	hooks := []VCRHookFunc{
+		VCRRedactHeaders(vcrRedactedHeaders...),
+		VCRRedactJSONFields(vcrKMSCiphertextFields...),
+		r.redactAccountIDs,
+	}
+	hooks = append(hooks, vcrHooks...)
+
+	for _, hook := range hooks {
+		if err := hook(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// redactAccountIDs replaces all occurrences of the discovered AWS account IDs with their placeholders.
+// Placeholders are the same length as account IDs so recorded content lengths remain valid.
+func (r *vcrRedactor) redactAccountIDs(i *cassette.Interaction) error {
+	if len(r.accountIDs) == 0 {
+		return nil
+	}
+
+	oldnew := make([]string, 0, 2*len(r.accountIDs))
+	for accountID, placeholder := range r.accountIDs {
+		oldnew = append(oldnew, accountID, placeholder)
+	}
+	replacer := strings.NewReplacer(oldnew...)
+
+	i.Request.URL = replacer.Replace(i.Request.URL)
+	i.Request.Body = replacer.Replace(i.Request.Body)
+	i.Response.Body = replacer.Replace(i.Response.Body)
+	replaceVCRHeaderValues(i.Request.Headers, replacer)
+	replaceVCRHeaderValues(i.Response.Headers, replacer)
+
+	for k, vs := range i.Request.Form {
+		for j, v := range vs {
+			i.Request.Form[k][j] = replacer.Replace(v)
+		}
+	}
+
+	return nil
+}
+
+// VCRRedactHeaders returns a hook that removes the specified HTTP headers from recorded requests and responses.
+func VCRRedactHeaders(headers ...string) VCRHookFunc {
+	return func(i *cassette.Interaction) error {
+		for _, header := range headers {
+			for _, h := range []http.Header{i.Request.Headers, i.Response.Headers} {
+				delete(h, header)
+				delete(h, http.CanonicalHeaderKey(header))
+			}
+		}
+
+		return nil
+	}
+}
+
+// VCRRedactJSONFields returns a hook that replaces the string values of the specified fields,
+// at any depth in recorded JSON request and response bodies, with placeholders.
+// A placeholder is derived from the value it replaces so that distinct values remain distinct
+// and requests echoing a redacted response value still match on replay.
+func VCRRedactJSONFields(fields ...string) VCRHookFunc {
+	redact := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		redact[field] = struct{}{}
+	}
+
+	return func(i *cassette.Interaction) error {
+		if body, ok, err := redactVCRJSONBody(i.Request.Body, redact); err != nil {
+			return err
+		} else if ok {
+			i.Request.Body = body
+			i.Request.ContentLength = int64(len(body))
+		}
+
+		if body, ok, err := redactVCRJSONBody(i.Response.Body, redact); err != nil {
+			return err
+		} else if ok {
+			i.Response.Body = body
+			i.Response.ContentLength = int64(len(body))
+			if i.Response.Headers.Get("Content-Length") != "" {
+				i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+			}
+		}
+
+		return nil
+	}
+}
+
+// redactVCRJSONBody redacts the specified fields in a JSON body, returning whether any field was redacted.
+// Bodies that are not JSON are left unchanged.
+func redactVCRJSONBody(body string, fields map[string]struct{}) (string, bool, error) {
+	if !strings.HasPrefix(strings.TrimSpace(body), "{") {
+		return body, false, nil
+	}
+
+	var v any
+
+	// Preserve the precision of large integers when re-encoding.
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return body, false, nil
+	}
+
+	if !redactVCRJSONValue(v, fields) {
+		return body, false, nil
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return body, false, fmt.Errorf("redacting recorded JSON: %w", err)
+	}
+
+	return string(b), true, nil
+}
+
+func redactVCRJSONValue(v any, fields map[string]struct{}) bool {
+	redacted := false
+
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if s, ok := e.(string); ok {
+				if _, ok := fields[k]; ok {
+					v[k] = vcrRedactedValue(s)
+					redacted = true
+					continue
+				}
+			}
+
+			if redactVCRJSONValue(e, fields) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, e := range v {
+			if redactVCRJSONValue(e, fields) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// vcrRedactedValue returns the base64-encoded placeholder for a redacted value.
+func vcrRedactedValue(v string) string {
+	if strings.HasPrefix(v, vcrRedactedValuePrefixBase64) {
+		// Already redacted.
+		return v
+	}
+
+	hash := sha256.Sum256([]byte(v))
+
+	return base64.StdEncoding.EncodeToString([]byte(vcrRedactedValuePrefix + hex.EncodeToString(hash[:8])))
+}
+
+func replaceVCRHeaderValues(h http.Header, replacer *strings.Replacer) {
+	for k, vs := range h {
+		for j, v := range vs {
+			h[k][j] = replacer.Replace(v)
+		}
+	}
+}
@@ -330,10 +330,12 @@ type AWSClient struct {
 	AccountID               string
 	DefaultTagsConfig       *tftags.DefaultConfig
 	DNSSuffix               string
+	GuardrailsConfig        *GuardrailsConfig
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert.MediaConvert
 	Partition               string
 	Region                  string
+	RequiredTagsConfig      *tftags.RequiredConfig
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session.Session
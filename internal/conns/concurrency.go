@@ -0,0 +1,125 @@
+package conns
+
+import (
+	"context"
+	"sync"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+// concurrencyLimiters returns a semaphore for each service package with a concurrency limit.
+// Semaphores are shared by all of a service's API clients, regardless of AWS SDK version.
+func (c *Config) concurrencyLimiters() map[string]tfsync.Semaphore {
+	if len(c.ConcurrencyLimits) == 0 {
+		return nil
+	}
+
+	semaphores := make(map[string]tfsync.Semaphore, len(c.ConcurrencyLimits))
+
+	for servicePackageName, limit := range c.ConcurrencyLimits {
+		if limit > 0 {
+			semaphores[servicePackageName] = tfsync.NewSemaphore(limit)
+		}
+	}
+
+	return semaphores
+}
+
+// acquireConcurrencyLimit waits until the specified service's concurrency limit allows another in-flight API request.
+// The returned function releases the request's slot and may be called more than once.
+func acquireConcurrencyLimit(ctx context.Context, semaphore tfsync.Semaphore, servicePackageName, operationName string) (func(), error) {
+	wait, err := semaphore.WaitContext(ctx)
+
+	fields := map[string]any{
+		"tf_aws.service_package":            servicePackageName,
+		"tf_aws.concurrency.limit":          cap(semaphore),
+		"tf_aws.concurrency.wait_ms":        wait.Milliseconds(),
+		"tf_aws.concurrency.operation_name": operationName,
+	}
+
+	if err != nil {
+		tflog.Warn(ctx, "Stopped waiting for API request concurrency limit", fields)
+
+		return nil, err
+	}
+
+	if wait > 0 {
+		tflog.Debug(ctx, "Waited for API request concurrency limit", fields)
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(semaphore.Notify)
+	}, nil
+}
+
+type concurrencyReleaseContextKeyType int
+
+var concurrencyReleaseContextKey concurrencyReleaseContextKeyType
+
+// addSDKv1ConcurrencyLimitHandlers adds handlers that limit the number of in-flight AWS SDK for Go v1 API requests.
+// Each attempt holds a slot while it is sent.
+func addSDKv1ConcurrencyLimitHandlers(handlers *request.Handlers, semaphore tfsync.Semaphore, servicePackageName string) {
+	release := func(r *request.Request) {
+		if f, ok := r.Context().Value(concurrencyReleaseContextKey).(func()); ok {
+			f()
+		}
+	}
+
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "tf-aws.ConcurrencyLimitAcquire",
+		Fn: func(r *request.Request) {
+			f, err := acquireConcurrencyLimit(r.Context(), semaphore, servicePackageName, r.Operation.Name)
+
+			if err != nil {
+				r.Error = err
+				r.Retryable = aws.Bool(false)
+
+				return
+			}
+
+			r.SetContext(context.WithValue(r.Context(), concurrencyReleaseContextKey, f))
+		},
+	})
+	handlers.Send.PushBackNamed(request.NamedHandler{
+		Name: "tf-aws.ConcurrencyLimitReleaseOnSend",
+		Fn:   release,
+	})
+	// Ensure that the slot is released if sending is interrupted.
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf-aws.ConcurrencyLimitReleaseOnComplete",
+		Fn:   release,
+	})
+}
+
+// sdkv2ConcurrencyLimit returns an AWS SDK for Go v2 API option that limits the number of in-flight API requests for the specified service package,
+// or nil if the service package has no concurrency limit.
+// Each attempt holds a slot while it is sent.
+func (c *Config) sdkv2ConcurrencyLimit(servicePackageName string) func(*middleware.Stack) error {
+	semaphore, ok := c.semaphores[servicePackageName]
+
+	if !ok {
+		return nil
+	}
+
+	return func(stack *middleware.Stack) error {
+		// Add after the retry middleware so that the limit applies to each attempt.
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("tf-aws.ConcurrencyLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			release, err := acquireConcurrencyLimit(ctx, semaphore, servicePackageName, awsmiddleware_sdkv2.GetOperationName(ctx))
+
+			if err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+
+			defer release()
+
+			return next.HandleFinalize(ctx, in)
+		}), middleware.After)
+	}
+}
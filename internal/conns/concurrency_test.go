@@ -0,0 +1,178 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go/service/iam"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newSlowServer returns a local HTTP stub that responds slowly to every request with a non-retryable error
+// and records the maximum number of concurrent requests.
+func newSlowServer(t *testing.T, contentType, body string) (*httptest.Server, *int32, *int32) {
+	t.Helper()
+
+	var inFlight, maxInFlight, requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			v := atomic.LoadInt32(&maxInFlight)
+			if n <= v || atomic.CompareAndSwapInt32(&maxInFlight, v, n) {
+				break
+			}
+		}
+
+		time.Sleep(50 * time.Millisecond)
+
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(body)) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests, &maxInFlight
+}
+
+func testConcurrencyConfig(concurrencyLimits map[string]int, endpoints map[string]string) *Config {
+	config := testRetryConfig("", 0, nil, endpoints)
+	config.ConcurrencyLimits = concurrencyLimits
+
+	return config
+}
+
+func TestConcurrencyLimitSDKv1(t *testing.T) {
+	t.Parallel()
+
+	const calls = 6
+
+	testCases := []struct {
+		name              string
+		concurrencyLimits map[string]int
+		wantMaxInFlight   int32
+	}{
+		{
+			name:              "service concurrency limit",
+			concurrencyLimits: map[string]int{names.IAM: 2},
+			wantMaxInFlight:   2,
+		},
+		{
+			name:              "other service concurrency limit",
+			concurrencyLimits: map[string]int{names.Organizations: 1},
+			wantMaxInFlight:   calls,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server, requests, maxInFlight := newSlowServer(t, "text/xml", `<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchEntity</Code><Message>not found</Message></Error><RequestId>00000000-0000-0000-0000-000000000000</RequestId></ErrorResponse>`)
+			config := testConcurrencyConfig(testCase.concurrencyLimits, map[string]string{
+				names.IAM: server.URL,
+			})
+
+			client, diags := config.ConfigureProvider(ctx, new(AWSClient))
+
+			if diags.HasError() {
+				t.Fatalf("configuring provider: %v", diags)
+			}
+
+			var wg sync.WaitGroup
+			for i := 0; i < calls; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					client.IAMConn().GetUserWithContext(ctx, &iam.GetUserInput{}) //nolint:errcheck
+				}()
+			}
+			wg.Wait()
+
+			if got, want := atomic.LoadInt32(requests), int32(calls); got != want {
+				t.Errorf("got %d requests, expected %d", got, want)
+			}
+
+			if got, want := atomic.LoadInt32(maxInFlight), testCase.wantMaxInFlight; got > want {
+				t.Errorf("got %d concurrent requests, expected at most %d", got, want)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimitSDKv2(t *testing.T) {
+	t.Parallel()
+
+	const calls = 6
+
+	ctx := context.Background()
+	server, requests, maxInFlight := newSlowServer(t, "application/x-amz-json-1.0", `{"__type":"ValidationException","message":"invalid"}`)
+	config := testConcurrencyConfig(map[string]int{names.ComputeOptimizer: 2}, map[string]string{
+		names.ComputeOptimizer: server.URL,
+	})
+
+	client, diags := config.ConfigureProvider(ctx, new(AWSClient))
+
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.ComputeOptimizerClient().GetEnrollmentStatus(ctx, &computeoptimizer.GetEnrollmentStatusInput{}) //nolint:errcheck
+		}()
+	}
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(requests), int32(calls); got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+
+	if got, want := atomic.LoadInt32(maxInFlight), int32(2); got > want {
+		t.Errorf("got %d concurrent requests, expected at most %d", got, want)
+	}
+}
+
+func TestAcquireConcurrencyLimitCanceled(t *testing.T) {
+	t.Parallel()
+
+	semaphore := tfsync.NewSemaphore(1)
+	release, err := acquireConcurrencyLimit(context.Background(), semaphore, names.ACM, "RequestCertificate")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := acquireConcurrencyLimit(ctx, semaphore, names.ACM, "RequestCertificate"); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	// Releasing more than once must not free another slot.
+	release()
+	release()
+
+	if _, err := acquireConcurrencyLimit(context.Background(), semaphore, names.ACM, "RequestCertificate"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(semaphore), 1; got != want {
+		t.Errorf("got %d slots in use, expected %d", got, want)
+	}
+}
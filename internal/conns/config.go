@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
@@ -41,6 +42,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -50,6 +52,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	ConcurrencyLimits              map[string]int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -57,12 +60,16 @@ type Config struct {
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	GuardrailsConfig               *GuardrailsConfig
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
+	RetryMode                      aws_sdkv2.RetryMode
+	RetryPolicies                  map[string]*RetryPolicy
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -77,6 +84,8 @@ type Config struct {
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+
+	semaphores map[string]tfsync.Semaphore
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -143,6 +152,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if httpClient := client.HTTPClient(); httpClient != nil {
+		// Ensure that AWS SDK for Go v2 API clients also use any caller-provided HTTP client, e.g. a VCR recorder.
+		cfg.HTTPClient = httpClient
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	sess, err := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 	if err != nil {
@@ -188,14 +202,35 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
+	client.GuardrailsConfig = c.GuardrailsConfig
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
+	if c.RetryMode == "" {
+		// Respect any retry mode set via the AWS_RETRY_MODE environment variable or shared config file.
+		c.RetryMode = cfg.RetryMode
+	}
+	if c.RetryMode != "" {
+		tflog.Debug(ctx, "Configuring AWS SDK retry mode", map[string]any{
+			"tf_aws.retry_mode": c.RetryMode,
+		})
+
+		// The retryer sets the maximum number of attempts.
+		cfg.RetryMaxAttempts = 0
+		cfg.RetryMode = c.RetryMode
+		cfg.Retryer = func() aws_sdkv2.Retryer {
+			return c.newSDKv2Retryer("")
+		}
+	}
+
+	c.semaphores = c.concurrencyLimiters()
+
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
 	c.sdkv2Conns(client, cfg)
@@ -204,40 +239,29 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	// AWS SDK for Go v1 custom API clients.
 
 	// STS.
-	stsConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.STS]),
-	}
+	stsConfig := &aws.Config{}
 	if c.STSRegion != "" {
 		stsConfig.Region = aws.String(c.STSRegion)
 	}
-	client.stsConn = sts.New(sess.Copy(stsConfig))
+	client.stsConn = sts.New(c.sdkv1Session(sess, names.STS, stsConfig))
 
 	// Services that require multiple client configurations.
 	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints[names.S3]),
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
-	client.s3Conn = s3.New(sess.Copy(s3Config))
+	client.s3Conn = s3.New(c.sdkv1Session(sess, names.S3, s3Config))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.s3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
+	client.s3ConnURICleaningDisabled = s3.New(c.sdkv1Session(sess, names.S3, s3Config))
 
 	// "Global" services that require customizations.
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.GlobalAccelerator]),
-	}
+	globalAcceleratorConfig := &aws.Config{}
 	route53Config := &aws.Config{
 		Endpoint: aws.String(c.Endpoints[names.Route53]),
 	}
-	route53RecoveryControlConfigConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53RecoveryControlConfig]),
-	}
-	route53RecoveryReadinessConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Route53RecoveryReadiness]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[names.Shield]),
-	}
+	route53RecoveryControlConfigConfig := &aws.Config{}
+	route53RecoveryReadinessConfig := &aws.Config{}
+	shieldConfig := &aws.Config{}
 
 	// Force "global" services to correct Regions.
 	switch partition {
@@ -258,11 +282,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.globalacceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.route53Conn = route53.New(sess.Copy(route53Config))
-	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.route53recoveryreadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.shieldConn = shield.New(sess.Copy(shieldConfig))
+	client.globalacceleratorConn = globalaccelerator.New(c.sdkv1Session(sess, names.GlobalAccelerator, globalAcceleratorConfig))
+	client.route53Conn = route53.New(c.sdkv1Session(sess, names.Route53, route53Config))
+	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(c.sdkv1Session(sess, names.Route53RecoveryControlConfig, route53RecoveryControlConfigConfig))
+	client.route53recoveryreadinessConn = route53recoveryreadiness.New(c.sdkv1Session(sess, names.Route53RecoveryReadiness, route53RecoveryReadinessConfig))
+	client.shieldConn = shield.New(c.sdkv1Session(sess, names.Shield, shieldConfig))
 
 	client.apigatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// Many operations can return an error such as:
@@ -545,6 +569,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
 			o.Region = endpoints.UsEast1RegionID
 		}
+		if retryer := c.sdkv2Retryer(names.Route53Domains); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.Route53Domains); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 
 	return client, nil
@@ -33,7 +33,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -320,286 +319,286 @@ import (
 
 // sdkv1Conns initializes AWS SDK for Go v1 clients.
 func (c *Config) sdkv1Conns(client *AWSClient, sess *session.Session) {
-	client.acmConn = acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])}))
-	client.acmpcaConn = acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])}))
-	client.ampConn = prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])}))
-	client.apigatewayConn = apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])}))
-	client.apigatewaymanagementapiConn = apigatewaymanagementapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])}))
-	client.apigatewayv2Conn = apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])}))
-	client.accessanalyzerConn = accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])}))
-	client.accountConn = account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Account])}))
-	client.alexaforbusinessConn = alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])}))
-	client.amplifyConn = amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])}))
-	client.amplifybackendConn = amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])}))
-	client.amplifyuibuilderConn = amplifyuibuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])}))
-	client.applicationautoscalingConn = applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])}))
-	client.appconfigConn = appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])}))
-	client.appconfigdataConn = appconfigdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])}))
-	client.appflowConn = appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])}))
-	client.appintegrationsConn = appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])}))
-	client.appmeshConn = appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])}))
-	client.apprunnerConn = apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])}))
-	client.appstreamConn = appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])}))
-	client.appsyncConn = appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])}))
-	client.applicationcostprofilerConn = applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])}))
-	client.applicationinsightsConn = applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])}))
-	client.athenaConn = athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])}))
-	client.autoscalingConn = autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])}))
-	client.autoscalingplansConn = autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])}))
-	client.backupConn = backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])}))
-	client.backupgatewayConn = backupgateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])}))
-	client.batchConn = batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])}))
-	client.billingconductorConn = billingconductor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])}))
-	client.braketConn = braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])}))
-	client.budgetsConn = budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])}))
-	client.ceConn = costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])}))
-	client.curConn = costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])}))
-	client.chimeConn = chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])}))
-	client.chimesdkidentityConn = chimesdkidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])}))
-	client.chimesdkmediapipelinesConn = chimesdkmediapipelines.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMediaPipelines])}))
-	client.chimesdkmeetingsConn = chimesdkmeetings.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])}))
-	client.chimesdkmessagingConn = chimesdkmessaging.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])}))
-	client.cloud9Conn = cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])}))
-	client.clouddirectoryConn = clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])}))
-	client.cloudformationConn = cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])}))
-	client.cloudfrontConn = cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])}))
-	client.cloudhsmv2Conn = cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])}))
-	client.cloudsearchConn = cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])}))
-	client.cloudsearchdomainConn = cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])}))
-	client.cloudtrailConn = cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])}))
-	client.cloudwatchConn = cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])}))
-	client.codeartifactConn = codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])}))
-	client.codebuildConn = codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])}))
-	client.codecommitConn = codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])}))
-	client.codeguruprofilerConn = codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])}))
-	client.codegurureviewerConn = codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])}))
-	client.codepipelineConn = codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])}))
-	client.codestarConn = codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])}))
-	client.codestarconnectionsConn = codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])}))
-	client.codestarnotificationsConn = codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])}))
-	client.cognitoidpConn = cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])}))
-	client.cognitoidentityConn = cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])}))
-	client.cognitosyncConn = cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])}))
-	client.comprehendmedicalConn = comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])}))
-	client.configserviceConn = configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])}))
-	client.connectConn = connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])}))
-	client.connectcontactlensConn = connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])}))
-	client.connectparticipantConn = connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])}))
-	client.controltowerConn = controltower.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ControlTower])}))
-	client.customerprofilesConn = customerprofiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])}))
-	client.daxConn = dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])}))
-	client.dlmConn = dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])}))
-	client.dmsConn = databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])}))
-	client.drsConn = drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])}))
-	client.dsConn = directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])}))
-	client.databrewConn = gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])}))
-	client.dataexchangeConn = dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])}))
-	client.datapipelineConn = datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])}))
-	client.datasyncConn = datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])}))
-	client.deployConn = codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])}))
-	client.detectiveConn = detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])}))
-	client.devopsguruConn = devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])}))
-	client.devicefarmConn = devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])}))
-	client.directconnectConn = directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])}))
-	client.discoveryConn = applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])}))
-	client.docdbConn = docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])}))
-	client.dynamodbConn = dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])}))
-	client.dynamodbstreamsConn = dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])}))
-	client.ebsConn = ebs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])}))
-	client.ec2Conn = ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])}))
-	client.ec2instanceconnectConn = ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])}))
-	client.ecrConn = ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])}))
-	client.ecrpublicConn = ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])}))
-	client.ecsConn = ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])}))
-	client.efsConn = efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])}))
-	client.eksConn = eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])}))
-	client.elbConn = elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])}))
-	client.elbv2Conn = elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])}))
-	client.emrConn = emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])}))
-	client.emrcontainersConn = emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])}))
-	client.emrserverlessConn = emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])}))
-	client.elasticacheConn = elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])}))
-	client.elasticbeanstalkConn = elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])}))
-	client.elasticinferenceConn = elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])}))
-	client.elastictranscoderConn = elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])}))
-	client.esConn = elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])}))
-	client.eventsConn = eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])}))
-	client.evidentlyConn = cloudwatchevidently.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])}))
-	client.fmsConn = fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])}))
-	client.fsxConn = fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])}))
-	client.finspaceConn = finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])}))
-	client.finspacedataConn = finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])}))
-	client.firehoseConn = firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])}))
-	client.forecastConn = forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])}))
-	client.forecastqueryConn = forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])}))
-	client.frauddetectorConn = frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])}))
-	client.gameliftConn = gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])}))
-	client.glacierConn = glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])}))
-	client.glueConn = glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])}))
-	client.grafanaConn = managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])}))
-	client.greengrassConn = greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])}))
-	client.greengrassv2Conn = greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])}))
-	client.groundstationConn = groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])}))
-	client.guarddutyConn = guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])}))
-	client.healthConn = health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])}))
-	client.honeycodeConn = honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])}))
-	client.iamConn = iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])}))
-	client.ivsConn = ivs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])}))
-	client.imagebuilderConn = imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])}))
-	client.inspectorConn = inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])}))
-	client.iotConn = iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])}))
-	client.iot1clickdevicesConn = iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])}))
-	client.iot1clickprojectsConn = iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])}))
-	client.iotanalyticsConn = iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])}))
-	client.iotdataConn = iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])}))
-	client.iotdeviceadvisorConn = iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])}))
-	client.ioteventsConn = iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])}))
-	client.ioteventsdataConn = ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])}))
-	client.iotfleethubConn = iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])}))
-	client.iotjobsdataConn = iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])}))
-	client.iotsecuretunnelingConn = iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])}))
-	client.iotsitewiseConn = iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])}))
-	client.iotthingsgraphConn = iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])}))
-	client.iottwinmakerConn = iottwinmaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])}))
-	client.iotwirelessConn = iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])}))
-	client.kmsConn = kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])}))
-	client.kafkaConn = kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])}))
-	client.kafkaconnectConn = kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])}))
-	client.keyspacesConn = keyspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])}))
-	client.kinesisConn = kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])}))
-	client.kinesisanalyticsConn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])}))
-	client.kinesisanalyticsv2Conn = kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])}))
-	client.kinesisvideoConn = kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])}))
-	client.kinesisvideoarchivedmediaConn = kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])}))
-	client.kinesisvideomediaConn = kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])}))
-	client.kinesisvideosignalingConn = kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])}))
-	client.lakeformationConn = lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])}))
-	client.lambdaConn = lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])}))
-	client.lexmodelsConn = lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])}))
-	client.lexmodelsv2Conn = lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])}))
-	client.lexruntimeConn = lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])}))
-	client.lexruntimev2Conn = lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])}))
-	client.licensemanagerConn = licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])}))
-	client.lightsailConn = lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])}))
-	client.locationConn = locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])}))
-	client.logsConn = cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])}))
-	client.lookoutequipmentConn = lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])}))
-	client.lookoutmetricsConn = lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])}))
-	client.lookoutvisionConn = lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])}))
-	client.mqConn = mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])}))
-	client.mturkConn = mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])}))
-	client.mwaaConn = mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])}))
-	client.machinelearningConn = machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])}))
-	client.macieConn = macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])}))
-	client.macie2Conn = macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])}))
-	client.managedblockchainConn = managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])}))
-	client.marketplacecatalogConn = marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])}))
-	client.marketplacecommerceanalyticsConn = marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])}))
-	client.marketplaceentitlementConn = marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])}))
-	client.marketplacemeteringConn = marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])}))
-	client.mediaconnectConn = mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])}))
-	client.mediaconvertConn = mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])}))
-	client.mediapackageConn = mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])}))
-	client.mediapackagevodConn = mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])}))
-	client.mediastoreConn = mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])}))
-	client.mediastoredataConn = mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])}))
-	client.mediatailorConn = mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])}))
-	client.memorydbConn = memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])}))
-	client.mghConn = migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])}))
-	client.mgnConn = mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])}))
-	client.migrationhubconfigConn = migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])}))
-	client.migrationhubrefactorspacesConn = migrationhubrefactorspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])}))
-	client.migrationhubstrategyConn = migrationhubstrategyrecommendations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])}))
-	client.mobileConn = mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])}))
-	client.neptuneConn = neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])}))
-	client.networkfirewallConn = networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])}))
-	client.networkmanagerConn = networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])}))
-	client.nimbleConn = nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])}))
-	client.opensearchConn = opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])}))
-	client.opsworksConn = opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])}))
-	client.opsworkscmConn = opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])}))
-	client.organizationsConn = organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])}))
-	client.outpostsConn = outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])}))
-	client.piConn = pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])}))
-	client.panoramaConn = panorama.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])}))
-	client.personalizeConn = personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])}))
-	client.personalizeeventsConn = personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])}))
-	client.personalizeruntimeConn = personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])}))
-	client.pinpointConn = pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])}))
-	client.pinpointemailConn = pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])}))
-	client.pinpointsmsvoiceConn = pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])}))
-	client.pollyConn = polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])}))
-	client.pricingConn = pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])}))
-	client.protonConn = proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])}))
-	client.qldbConn = qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])}))
-	client.qldbsessionConn = qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])}))
-	client.quicksightConn = quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])}))
-	client.ramConn = ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])}))
-	client.rdsConn = rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])}))
-	client.rdsdataConn = rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])}))
-	client.rumConn = cloudwatchrum.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])}))
-	client.redshiftConn = redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])}))
-	client.redshiftdataConn = redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])}))
-	client.redshiftserverlessConn = redshiftserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftServerless])}))
-	client.rekognitionConn = rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])}))
-	client.resiliencehubConn = resiliencehub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])}))
-	client.resourcegroupsConn = resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])}))
-	client.resourcegroupstaggingapiConn = resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])}))
-	client.robomakerConn = robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])}))
-	client.route53recoveryclusterConn = route53recoverycluster.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])}))
-	client.route53resolverConn = route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])}))
-	client.s3controlConn = s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])}))
-	client.s3outpostsConn = s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])}))
-	client.sesConn = ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])}))
-	client.sfnConn = sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])}))
-	client.smsConn = sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])}))
-	client.snsConn = sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])}))
-	client.sqsConn = sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])}))
-	client.ssmConn = ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])}))
-	client.ssoConn = sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])}))
-	client.ssoadminConn = ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])}))
-	client.ssooidcConn = ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])}))
-	client.swfConn = swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])}))
-	client.sagemakerConn = sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])}))
-	client.sagemakera2iruntimeConn = augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])}))
-	client.sagemakeredgeConn = sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])}))
-	client.sagemakerfeaturestoreruntimeConn = sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])}))
-	client.sagemakerruntimeConn = sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])}))
-	client.savingsplansConn = savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])}))
-	client.schemasConn = schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])}))
-	client.secretsmanagerConn = secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])}))
-	client.securityhubConn = securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])}))
-	client.serverlessrepoConn = serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])}))
-	client.servicecatalogConn = servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])}))
-	client.servicecatalogappregistryConn = appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])}))
-	client.servicediscoveryConn = servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])}))
-	client.servicequotasConn = servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])}))
-	client.signerConn = signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])}))
-	client.sdbConn = simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])}))
-	client.snowdevicemanagementConn = snowdevicemanagement.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])}))
-	client.snowballConn = snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])}))
-	client.storagegatewayConn = storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])}))
-	client.supportConn = support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])}))
-	client.syntheticsConn = synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])}))
-	client.textractConn = textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])}))
-	client.timestreamqueryConn = timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])}))
-	client.timestreamwriteConn = timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])}))
-	client.transcribestreamingConn = transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])}))
-	client.transferConn = transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])}))
-	client.translateConn = translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])}))
-	client.voiceidConn = voiceid.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])}))
-	client.wafConn = waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])}))
-	client.wafregionalConn = wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])}))
-	client.wafv2Conn = wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])}))
-	client.wellarchitectedConn = wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])}))
-	client.wisdomConn = connectwisdomservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])}))
-	client.workdocsConn = workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])}))
-	client.worklinkConn = worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])}))
-	client.workmailConn = workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])}))
-	client.workmailmessageflowConn = workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])}))
-	client.workspacesConn = workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])}))
-	client.workspaceswebConn = workspacesweb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])}))
-	client.xrayConn = xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])}))
+	client.acmConn = acm.New(c.sdkv1Session(sess, names.ACM))
+	client.acmpcaConn = acmpca.New(c.sdkv1Session(sess, names.ACMPCA))
+	client.ampConn = prometheusservice.New(c.sdkv1Session(sess, names.AMP))
+	client.apigatewayConn = apigateway.New(c.sdkv1Session(sess, names.APIGateway))
+	client.apigatewaymanagementapiConn = apigatewaymanagementapi.New(c.sdkv1Session(sess, names.APIGatewayManagementAPI))
+	client.apigatewayv2Conn = apigatewayv2.New(c.sdkv1Session(sess, names.APIGatewayV2))
+	client.accessanalyzerConn = accessanalyzer.New(c.sdkv1Session(sess, names.AccessAnalyzer))
+	client.accountConn = account.New(c.sdkv1Session(sess, names.Account))
+	client.alexaforbusinessConn = alexaforbusiness.New(c.sdkv1Session(sess, names.AlexaForBusiness))
+	client.amplifyConn = amplify.New(c.sdkv1Session(sess, names.Amplify))
+	client.amplifybackendConn = amplifybackend.New(c.sdkv1Session(sess, names.AmplifyBackend))
+	client.amplifyuibuilderConn = amplifyuibuilder.New(c.sdkv1Session(sess, names.AmplifyUIBuilder))
+	client.applicationautoscalingConn = applicationautoscaling.New(c.sdkv1Session(sess, names.AppAutoScaling))
+	client.appconfigConn = appconfig.New(c.sdkv1Session(sess, names.AppConfig))
+	client.appconfigdataConn = appconfigdata.New(c.sdkv1Session(sess, names.AppConfigData))
+	client.appflowConn = appflow.New(c.sdkv1Session(sess, names.AppFlow))
+	client.appintegrationsConn = appintegrationsservice.New(c.sdkv1Session(sess, names.AppIntegrations))
+	client.appmeshConn = appmesh.New(c.sdkv1Session(sess, names.AppMesh))
+	client.apprunnerConn = apprunner.New(c.sdkv1Session(sess, names.AppRunner))
+	client.appstreamConn = appstream.New(c.sdkv1Session(sess, names.AppStream))
+	client.appsyncConn = appsync.New(c.sdkv1Session(sess, names.AppSync))
+	client.applicationcostprofilerConn = applicationcostprofiler.New(c.sdkv1Session(sess, names.ApplicationCostProfiler))
+	client.applicationinsightsConn = applicationinsights.New(c.sdkv1Session(sess, names.ApplicationInsights))
+	client.athenaConn = athena.New(c.sdkv1Session(sess, names.Athena))
+	client.autoscalingConn = autoscaling.New(c.sdkv1Session(sess, names.AutoScaling))
+	client.autoscalingplansConn = autoscalingplans.New(c.sdkv1Session(sess, names.AutoScalingPlans))
+	client.backupConn = backup.New(c.sdkv1Session(sess, names.Backup))
+	client.backupgatewayConn = backupgateway.New(c.sdkv1Session(sess, names.BackupGateway))
+	client.batchConn = batch.New(c.sdkv1Session(sess, names.Batch))
+	client.billingconductorConn = billingconductor.New(c.sdkv1Session(sess, names.BillingConductor))
+	client.braketConn = braket.New(c.sdkv1Session(sess, names.Braket))
+	client.budgetsConn = budgets.New(c.sdkv1Session(sess, names.Budgets))
+	client.ceConn = costexplorer.New(c.sdkv1Session(sess, names.CE))
+	client.curConn = costandusagereportservice.New(c.sdkv1Session(sess, names.CUR))
+	client.chimeConn = chime.New(c.sdkv1Session(sess, names.Chime))
+	client.chimesdkidentityConn = chimesdkidentity.New(c.sdkv1Session(sess, names.ChimeSDKIdentity))
+	client.chimesdkmediapipelinesConn = chimesdkmediapipelines.New(c.sdkv1Session(sess, names.ChimeSDKMediaPipelines))
+	client.chimesdkmeetingsConn = chimesdkmeetings.New(c.sdkv1Session(sess, names.ChimeSDKMeetings))
+	client.chimesdkmessagingConn = chimesdkmessaging.New(c.sdkv1Session(sess, names.ChimeSDKMessaging))
+	client.cloud9Conn = cloud9.New(c.sdkv1Session(sess, names.Cloud9))
+	client.clouddirectoryConn = clouddirectory.New(c.sdkv1Session(sess, names.CloudDirectory))
+	client.cloudformationConn = cloudformation.New(c.sdkv1Session(sess, names.CloudFormation))
+	client.cloudfrontConn = cloudfront.New(c.sdkv1Session(sess, names.CloudFront))
+	client.cloudhsmv2Conn = cloudhsmv2.New(c.sdkv1Session(sess, names.CloudHSMV2))
+	client.cloudsearchConn = cloudsearch.New(c.sdkv1Session(sess, names.CloudSearch))
+	client.cloudsearchdomainConn = cloudsearchdomain.New(c.sdkv1Session(sess, names.CloudSearchDomain))
+	client.cloudtrailConn = cloudtrail.New(c.sdkv1Session(sess, names.CloudTrail))
+	client.cloudwatchConn = cloudwatch.New(c.sdkv1Session(sess, names.CloudWatch))
+	client.codeartifactConn = codeartifact.New(c.sdkv1Session(sess, names.CodeArtifact))
+	client.codebuildConn = codebuild.New(c.sdkv1Session(sess, names.CodeBuild))
+	client.codecommitConn = codecommit.New(c.sdkv1Session(sess, names.CodeCommit))
+	client.codeguruprofilerConn = codeguruprofiler.New(c.sdkv1Session(sess, names.CodeGuruProfiler))
+	client.codegurureviewerConn = codegurureviewer.New(c.sdkv1Session(sess, names.CodeGuruReviewer))
+	client.codepipelineConn = codepipeline.New(c.sdkv1Session(sess, names.CodePipeline))
+	client.codestarConn = codestar.New(c.sdkv1Session(sess, names.CodeStar))
+	client.codestarconnectionsConn = codestarconnections.New(c.sdkv1Session(sess, names.CodeStarConnections))
+	client.codestarnotificationsConn = codestarnotifications.New(c.sdkv1Session(sess, names.CodeStarNotifications))
+	client.cognitoidpConn = cognitoidentityprovider.New(c.sdkv1Session(sess, names.CognitoIDP))
+	client.cognitoidentityConn = cognitoidentity.New(c.sdkv1Session(sess, names.CognitoIdentity))
+	client.cognitosyncConn = cognitosync.New(c.sdkv1Session(sess, names.CognitoSync))
+	client.comprehendmedicalConn = comprehendmedical.New(c.sdkv1Session(sess, names.ComprehendMedical))
+	client.configserviceConn = configservice.New(c.sdkv1Session(sess, names.ConfigService))
+	client.connectConn = connect.New(c.sdkv1Session(sess, names.Connect))
+	client.connectcontactlensConn = connectcontactlens.New(c.sdkv1Session(sess, names.ConnectContactLens))
+	client.connectparticipantConn = connectparticipant.New(c.sdkv1Session(sess, names.ConnectParticipant))
+	client.controltowerConn = controltower.New(c.sdkv1Session(sess, names.ControlTower))
+	client.customerprofilesConn = customerprofiles.New(c.sdkv1Session(sess, names.CustomerProfiles))
+	client.daxConn = dax.New(c.sdkv1Session(sess, names.DAX))
+	client.dlmConn = dlm.New(c.sdkv1Session(sess, names.DLM))
+	client.dmsConn = databasemigrationservice.New(c.sdkv1Session(sess, names.DMS))
+	client.drsConn = drs.New(c.sdkv1Session(sess, names.DRS))
+	client.dsConn = directoryservice.New(c.sdkv1Session(sess, names.DS))
+	client.databrewConn = gluedatabrew.New(c.sdkv1Session(sess, names.DataBrew))
+	client.dataexchangeConn = dataexchange.New(c.sdkv1Session(sess, names.DataExchange))
+	client.datapipelineConn = datapipeline.New(c.sdkv1Session(sess, names.DataPipeline))
+	client.datasyncConn = datasync.New(c.sdkv1Session(sess, names.DataSync))
+	client.deployConn = codedeploy.New(c.sdkv1Session(sess, names.Deploy))
+	client.detectiveConn = detective.New(c.sdkv1Session(sess, names.Detective))
+	client.devopsguruConn = devopsguru.New(c.sdkv1Session(sess, names.DevOpsGuru))
+	client.devicefarmConn = devicefarm.New(c.sdkv1Session(sess, names.DeviceFarm))
+	client.directconnectConn = directconnect.New(c.sdkv1Session(sess, names.DirectConnect))
+	client.discoveryConn = applicationdiscoveryservice.New(c.sdkv1Session(sess, names.Discovery))
+	client.docdbConn = docdb.New(c.sdkv1Session(sess, names.DocDB))
+	client.dynamodbConn = dynamodb.New(c.sdkv1Session(sess, names.DynamoDB))
+	client.dynamodbstreamsConn = dynamodbstreams.New(c.sdkv1Session(sess, names.DynamoDBStreams))
+	client.ebsConn = ebs.New(c.sdkv1Session(sess, names.EBS))
+	client.ec2Conn = ec2.New(c.sdkv1Session(sess, names.EC2))
+	client.ec2instanceconnectConn = ec2instanceconnect.New(c.sdkv1Session(sess, names.EC2InstanceConnect))
+	client.ecrConn = ecr.New(c.sdkv1Session(sess, names.ECR))
+	client.ecrpublicConn = ecrpublic.New(c.sdkv1Session(sess, names.ECRPublic))
+	client.ecsConn = ecs.New(c.sdkv1Session(sess, names.ECS))
+	client.efsConn = efs.New(c.sdkv1Session(sess, names.EFS))
+	client.eksConn = eks.New(c.sdkv1Session(sess, names.EKS))
+	client.elbConn = elb.New(c.sdkv1Session(sess, names.ELB))
+	client.elbv2Conn = elbv2.New(c.sdkv1Session(sess, names.ELBV2))
+	client.emrConn = emr.New(c.sdkv1Session(sess, names.EMR))
+	client.emrcontainersConn = emrcontainers.New(c.sdkv1Session(sess, names.EMRContainers))
+	client.emrserverlessConn = emrserverless.New(c.sdkv1Session(sess, names.EMRServerless))
+	client.elasticacheConn = elasticache.New(c.sdkv1Session(sess, names.ElastiCache))
+	client.elasticbeanstalkConn = elasticbeanstalk.New(c.sdkv1Session(sess, names.ElasticBeanstalk))
+	client.elasticinferenceConn = elasticinference.New(c.sdkv1Session(sess, names.ElasticInference))
+	client.elastictranscoderConn = elastictranscoder.New(c.sdkv1Session(sess, names.ElasticTranscoder))
+	client.esConn = elasticsearchservice.New(c.sdkv1Session(sess, names.Elasticsearch))
+	client.eventsConn = eventbridge.New(c.sdkv1Session(sess, names.Events))
+	client.evidentlyConn = cloudwatchevidently.New(c.sdkv1Session(sess, names.Evidently))
+	client.fmsConn = fms.New(c.sdkv1Session(sess, names.FMS))
+	client.fsxConn = fsx.New(c.sdkv1Session(sess, names.FSx))
+	client.finspaceConn = finspace.New(c.sdkv1Session(sess, names.FinSpace))
+	client.finspacedataConn = finspacedata.New(c.sdkv1Session(sess, names.FinSpaceData))
+	client.firehoseConn = firehose.New(c.sdkv1Session(sess, names.Firehose))
+	client.forecastConn = forecastservice.New(c.sdkv1Session(sess, names.Forecast))
+	client.forecastqueryConn = forecastqueryservice.New(c.sdkv1Session(sess, names.ForecastQuery))
+	client.frauddetectorConn = frauddetector.New(c.sdkv1Session(sess, names.FraudDetector))
+	client.gameliftConn = gamelift.New(c.sdkv1Session(sess, names.GameLift))
+	client.glacierConn = glacier.New(c.sdkv1Session(sess, names.Glacier))
+	client.glueConn = glue.New(c.sdkv1Session(sess, names.Glue))
+	client.grafanaConn = managedgrafana.New(c.sdkv1Session(sess, names.Grafana))
+	client.greengrassConn = greengrass.New(c.sdkv1Session(sess, names.Greengrass))
+	client.greengrassv2Conn = greengrassv2.New(c.sdkv1Session(sess, names.GreengrassV2))
+	client.groundstationConn = groundstation.New(c.sdkv1Session(sess, names.GroundStation))
+	client.guarddutyConn = guardduty.New(c.sdkv1Session(sess, names.GuardDuty))
+	client.healthConn = health.New(c.sdkv1Session(sess, names.Health))
+	client.honeycodeConn = honeycode.New(c.sdkv1Session(sess, names.Honeycode))
+	client.iamConn = iam.New(c.sdkv1Session(sess, names.IAM))
+	client.ivsConn = ivs.New(c.sdkv1Session(sess, names.IVS))
+	client.imagebuilderConn = imagebuilder.New(c.sdkv1Session(sess, names.ImageBuilder))
+	client.inspectorConn = inspector.New(c.sdkv1Session(sess, names.Inspector))
+	client.iotConn = iot.New(c.sdkv1Session(sess, names.IoT))
+	client.iot1clickdevicesConn = iot1clickdevicesservice.New(c.sdkv1Session(sess, names.IoT1ClickDevices))
+	client.iot1clickprojectsConn = iot1clickprojects.New(c.sdkv1Session(sess, names.IoT1ClickProjects))
+	client.iotanalyticsConn = iotanalytics.New(c.sdkv1Session(sess, names.IoTAnalytics))
+	client.iotdataConn = iotdataplane.New(c.sdkv1Session(sess, names.IoTData))
+	client.iotdeviceadvisorConn = iotdeviceadvisor.New(c.sdkv1Session(sess, names.IoTDeviceAdvisor))
+	client.ioteventsConn = iotevents.New(c.sdkv1Session(sess, names.IoTEvents))
+	client.ioteventsdataConn = ioteventsdata.New(c.sdkv1Session(sess, names.IoTEventsData))
+	client.iotfleethubConn = iotfleethub.New(c.sdkv1Session(sess, names.IoTFleetHub))
+	client.iotjobsdataConn = iotjobsdataplane.New(c.sdkv1Session(sess, names.IoTJobsData))
+	client.iotsecuretunnelingConn = iotsecuretunneling.New(c.sdkv1Session(sess, names.IoTSecureTunneling))
+	client.iotsitewiseConn = iotsitewise.New(c.sdkv1Session(sess, names.IoTSiteWise))
+	client.iotthingsgraphConn = iotthingsgraph.New(c.sdkv1Session(sess, names.IoTThingsGraph))
+	client.iottwinmakerConn = iottwinmaker.New(c.sdkv1Session(sess, names.IoTTwinMaker))
+	client.iotwirelessConn = iotwireless.New(c.sdkv1Session(sess, names.IoTWireless))
+	client.kmsConn = kms.New(c.sdkv1Session(sess, names.KMS))
+	client.kafkaConn = kafka.New(c.sdkv1Session(sess, names.Kafka))
+	client.kafkaconnectConn = kafkaconnect.New(c.sdkv1Session(sess, names.KafkaConnect))
+	client.keyspacesConn = keyspaces.New(c.sdkv1Session(sess, names.Keyspaces))
+	client.kinesisConn = kinesis.New(c.sdkv1Session(sess, names.Kinesis))
+	client.kinesisanalyticsConn = kinesisanalytics.New(c.sdkv1Session(sess, names.KinesisAnalytics))
+	client.kinesisanalyticsv2Conn = kinesisanalyticsv2.New(c.sdkv1Session(sess, names.KinesisAnalyticsV2))
+	client.kinesisvideoConn = kinesisvideo.New(c.sdkv1Session(sess, names.KinesisVideo))
+	client.kinesisvideoarchivedmediaConn = kinesisvideoarchivedmedia.New(c.sdkv1Session(sess, names.KinesisVideoArchivedMedia))
+	client.kinesisvideomediaConn = kinesisvideomedia.New(c.sdkv1Session(sess, names.KinesisVideoMedia))
+	client.kinesisvideosignalingConn = kinesisvideosignalingchannels.New(c.sdkv1Session(sess, names.KinesisVideoSignaling))
+	client.lakeformationConn = lakeformation.New(c.sdkv1Session(sess, names.LakeFormation))
+	client.lambdaConn = lambda.New(c.sdkv1Session(sess, names.Lambda))
+	client.lexmodelsConn = lexmodelbuildingservice.New(c.sdkv1Session(sess, names.LexModels))
+	client.lexmodelsv2Conn = lexmodelsv2.New(c.sdkv1Session(sess, names.LexModelsV2))
+	client.lexruntimeConn = lexruntimeservice.New(c.sdkv1Session(sess, names.LexRuntime))
+	client.lexruntimev2Conn = lexruntimev2.New(c.sdkv1Session(sess, names.LexRuntimeV2))
+	client.licensemanagerConn = licensemanager.New(c.sdkv1Session(sess, names.LicenseManager))
+	client.lightsailConn = lightsail.New(c.sdkv1Session(sess, names.Lightsail))
+	client.locationConn = locationservice.New(c.sdkv1Session(sess, names.Location))
+	client.logsConn = cloudwatchlogs.New(c.sdkv1Session(sess, names.Logs))
+	client.lookoutequipmentConn = lookoutequipment.New(c.sdkv1Session(sess, names.LookoutEquipment))
+	client.lookoutmetricsConn = lookoutmetrics.New(c.sdkv1Session(sess, names.LookoutMetrics))
+	client.lookoutvisionConn = lookoutforvision.New(c.sdkv1Session(sess, names.LookoutVision))
+	client.mqConn = mq.New(c.sdkv1Session(sess, names.MQ))
+	client.mturkConn = mturk.New(c.sdkv1Session(sess, names.MTurk))
+	client.mwaaConn = mwaa.New(c.sdkv1Session(sess, names.MWAA))
+	client.machinelearningConn = machinelearning.New(c.sdkv1Session(sess, names.MachineLearning))
+	client.macieConn = macie.New(c.sdkv1Session(sess, names.Macie))
+	client.macie2Conn = macie2.New(c.sdkv1Session(sess, names.Macie2))
+	client.managedblockchainConn = managedblockchain.New(c.sdkv1Session(sess, names.ManagedBlockchain))
+	client.marketplacecatalogConn = marketplacecatalog.New(c.sdkv1Session(sess, names.MarketplaceCatalog))
+	client.marketplacecommerceanalyticsConn = marketplacecommerceanalytics.New(c.sdkv1Session(sess, names.MarketplaceCommerceAnalytics))
+	client.marketplaceentitlementConn = marketplaceentitlementservice.New(c.sdkv1Session(sess, names.MarketplaceEntitlement))
+	client.marketplacemeteringConn = marketplacemetering.New(c.sdkv1Session(sess, names.MarketplaceMetering))
+	client.mediaconnectConn = mediaconnect.New(c.sdkv1Session(sess, names.MediaConnect))
+	client.mediaconvertConn = mediaconvert.New(c.sdkv1Session(sess, names.MediaConvert))
+	client.mediapackageConn = mediapackage.New(c.sdkv1Session(sess, names.MediaPackage))
+	client.mediapackagevodConn = mediapackagevod.New(c.sdkv1Session(sess, names.MediaPackageVOD))
+	client.mediastoreConn = mediastore.New(c.sdkv1Session(sess, names.MediaStore))
+	client.mediastoredataConn = mediastoredata.New(c.sdkv1Session(sess, names.MediaStoreData))
+	client.mediatailorConn = mediatailor.New(c.sdkv1Session(sess, names.MediaTailor))
+	client.memorydbConn = memorydb.New(c.sdkv1Session(sess, names.MemoryDB))
+	client.mghConn = migrationhub.New(c.sdkv1Session(sess, names.MgH))
+	client.mgnConn = mgn.New(c.sdkv1Session(sess, names.Mgn))
+	client.migrationhubconfigConn = migrationhubconfig.New(c.sdkv1Session(sess, names.MigrationHubConfig))
+	client.migrationhubrefactorspacesConn = migrationhubrefactorspaces.New(c.sdkv1Session(sess, names.MigrationHubRefactorSpaces))
+	client.migrationhubstrategyConn = migrationhubstrategyrecommendations.New(c.sdkv1Session(sess, names.MigrationHubStrategy))
+	client.mobileConn = mobile.New(c.sdkv1Session(sess, names.Mobile))
+	client.neptuneConn = neptune.New(c.sdkv1Session(sess, names.Neptune))
+	client.networkfirewallConn = networkfirewall.New(c.sdkv1Session(sess, names.NetworkFirewall))
+	client.networkmanagerConn = networkmanager.New(c.sdkv1Session(sess, names.NetworkManager))
+	client.nimbleConn = nimblestudio.New(c.sdkv1Session(sess, names.Nimble))
+	client.opensearchConn = opensearchservice.New(c.sdkv1Session(sess, names.OpenSearch))
+	client.opsworksConn = opsworks.New(c.sdkv1Session(sess, names.OpsWorks))
+	client.opsworkscmConn = opsworkscm.New(c.sdkv1Session(sess, names.OpsWorksCM))
+	client.organizationsConn = organizations.New(c.sdkv1Session(sess, names.Organizations))
+	client.outpostsConn = outposts.New(c.sdkv1Session(sess, names.Outposts))
+	client.piConn = pi.New(c.sdkv1Session(sess, names.PI))
+	client.panoramaConn = panorama.New(c.sdkv1Session(sess, names.Panorama))
+	client.personalizeConn = personalize.New(c.sdkv1Session(sess, names.Personalize))
+	client.personalizeeventsConn = personalizeevents.New(c.sdkv1Session(sess, names.PersonalizeEvents))
+	client.personalizeruntimeConn = personalizeruntime.New(c.sdkv1Session(sess, names.PersonalizeRuntime))
+	client.pinpointConn = pinpoint.New(c.sdkv1Session(sess, names.Pinpoint))
+	client.pinpointemailConn = pinpointemail.New(c.sdkv1Session(sess, names.PinpointEmail))
+	client.pinpointsmsvoiceConn = pinpointsmsvoice.New(c.sdkv1Session(sess, names.PinpointSMSVoice))
+	client.pollyConn = polly.New(c.sdkv1Session(sess, names.Polly))
+	client.pricingConn = pricing.New(c.sdkv1Session(sess, names.Pricing))
+	client.protonConn = proton.New(c.sdkv1Session(sess, names.Proton))
+	client.qldbConn = qldb.New(c.sdkv1Session(sess, names.QLDB))
+	client.qldbsessionConn = qldbsession.New(c.sdkv1Session(sess, names.QLDBSession))
+	client.quicksightConn = quicksight.New(c.sdkv1Session(sess, names.QuickSight))
+	client.ramConn = ram.New(c.sdkv1Session(sess, names.RAM))
+	client.rdsConn = rds.New(c.sdkv1Session(sess, names.RDS))
+	client.rdsdataConn = rdsdataservice.New(c.sdkv1Session(sess, names.RDSData))
+	client.rumConn = cloudwatchrum.New(c.sdkv1Session(sess, names.RUM))
+	client.redshiftConn = redshift.New(c.sdkv1Session(sess, names.Redshift))
+	client.redshiftdataConn = redshiftdataapiservice.New(c.sdkv1Session(sess, names.RedshiftData))
+	client.redshiftserverlessConn = redshiftserverless.New(c.sdkv1Session(sess, names.RedshiftServerless))
+	client.rekognitionConn = rekognition.New(c.sdkv1Session(sess, names.Rekognition))
+	client.resiliencehubConn = resiliencehub.New(c.sdkv1Session(sess, names.ResilienceHub))
+	client.resourcegroupsConn = resourcegroups.New(c.sdkv1Session(sess, names.ResourceGroups))
+	client.resourcegroupstaggingapiConn = resourcegroupstaggingapi.New(c.sdkv1Session(sess, names.ResourceGroupsTaggingAPI))
+	client.robomakerConn = robomaker.New(c.sdkv1Session(sess, names.RoboMaker))
+	client.route53recoveryclusterConn = route53recoverycluster.New(c.sdkv1Session(sess, names.Route53RecoveryCluster))
+	client.route53resolverConn = route53resolver.New(c.sdkv1Session(sess, names.Route53Resolver))
+	client.s3controlConn = s3control.New(c.sdkv1Session(sess, names.S3Control))
+	client.s3outpostsConn = s3outposts.New(c.sdkv1Session(sess, names.S3Outposts))
+	client.sesConn = ses.New(c.sdkv1Session(sess, names.SES))
+	client.sfnConn = sfn.New(c.sdkv1Session(sess, names.SFN))
+	client.smsConn = sms.New(c.sdkv1Session(sess, names.SMS))
+	client.snsConn = sns.New(c.sdkv1Session(sess, names.SNS))
+	client.sqsConn = sqs.New(c.sdkv1Session(sess, names.SQS))
+	client.ssmConn = ssm.New(c.sdkv1Session(sess, names.SSM))
+	client.ssoConn = sso.New(c.sdkv1Session(sess, names.SSO))
+	client.ssoadminConn = ssoadmin.New(c.sdkv1Session(sess, names.SSOAdmin))
+	client.ssooidcConn = ssooidc.New(c.sdkv1Session(sess, names.SSOOIDC))
+	client.swfConn = swf.New(c.sdkv1Session(sess, names.SWF))
+	client.sagemakerConn = sagemaker.New(c.sdkv1Session(sess, names.SageMaker))
+	client.sagemakera2iruntimeConn = augmentedairuntime.New(c.sdkv1Session(sess, names.SageMakerA2IRuntime))
+	client.sagemakeredgeConn = sagemakeredgemanager.New(c.sdkv1Session(sess, names.SageMakerEdge))
+	client.sagemakerfeaturestoreruntimeConn = sagemakerfeaturestoreruntime.New(c.sdkv1Session(sess, names.SageMakerFeatureStoreRuntime))
+	client.sagemakerruntimeConn = sagemakerruntime.New(c.sdkv1Session(sess, names.SageMakerRuntime))
+	client.savingsplansConn = savingsplans.New(c.sdkv1Session(sess, names.SavingsPlans))
+	client.schemasConn = schemas.New(c.sdkv1Session(sess, names.Schemas))
+	client.secretsmanagerConn = secretsmanager.New(c.sdkv1Session(sess, names.SecretsManager))
+	client.securityhubConn = securityhub.New(c.sdkv1Session(sess, names.SecurityHub))
+	client.serverlessrepoConn = serverlessapplicationrepository.New(c.sdkv1Session(sess, names.ServerlessRepo))
+	client.servicecatalogConn = servicecatalog.New(c.sdkv1Session(sess, names.ServiceCatalog))
+	client.servicecatalogappregistryConn = appregistry.New(c.sdkv1Session(sess, names.ServiceCatalogAppRegistry))
+	client.servicediscoveryConn = servicediscovery.New(c.sdkv1Session(sess, names.ServiceDiscovery))
+	client.servicequotasConn = servicequotas.New(c.sdkv1Session(sess, names.ServiceQuotas))
+	client.signerConn = signer.New(c.sdkv1Session(sess, names.Signer))
+	client.sdbConn = simpledb.New(c.sdkv1Session(sess, names.SimpleDB))
+	client.snowdevicemanagementConn = snowdevicemanagement.New(c.sdkv1Session(sess, names.SnowDeviceManagement))
+	client.snowballConn = snowball.New(c.sdkv1Session(sess, names.Snowball))
+	client.storagegatewayConn = storagegateway.New(c.sdkv1Session(sess, names.StorageGateway))
+	client.supportConn = support.New(c.sdkv1Session(sess, names.Support))
+	client.syntheticsConn = synthetics.New(c.sdkv1Session(sess, names.Synthetics))
+	client.textractConn = textract.New(c.sdkv1Session(sess, names.Textract))
+	client.timestreamqueryConn = timestreamquery.New(c.sdkv1Session(sess, names.TimestreamQuery))
+	client.timestreamwriteConn = timestreamwrite.New(c.sdkv1Session(sess, names.TimestreamWrite))
+	client.transcribestreamingConn = transcribestreamingservice.New(c.sdkv1Session(sess, names.TranscribeStreaming))
+	client.transferConn = transfer.New(c.sdkv1Session(sess, names.Transfer))
+	client.translateConn = translate.New(c.sdkv1Session(sess, names.Translate))
+	client.voiceidConn = voiceid.New(c.sdkv1Session(sess, names.VoiceID))
+	client.wafConn = waf.New(c.sdkv1Session(sess, names.WAF))
+	client.wafregionalConn = wafregional.New(c.sdkv1Session(sess, names.WAFRegional))
+	client.wafv2Conn = wafv2.New(c.sdkv1Session(sess, names.WAFV2))
+	client.wellarchitectedConn = wellarchitected.New(c.sdkv1Session(sess, names.WellArchitected))
+	client.wisdomConn = connectwisdomservice.New(c.sdkv1Session(sess, names.Wisdom))
+	client.workdocsConn = workdocs.New(c.sdkv1Session(sess, names.WorkDocs))
+	client.worklinkConn = worklink.New(c.sdkv1Session(sess, names.WorkLink))
+	client.workmailConn = workmail.New(c.sdkv1Session(sess, names.WorkMail))
+	client.workmailmessageflowConn = workmailmessageflow.New(c.sdkv1Session(sess, names.WorkMailMessageFlow))
+	client.workspacesConn = workspaces.New(c.sdkv1Session(sess, names.WorkSpaces))
+	client.workspaceswebConn = workspacesweb.New(c.sdkv1Session(sess, names.WorkSpacesWeb))
+	client.xrayConn = xray.New(c.sdkv1Session(sess, names.XRay))
 }
 
 // sdkv2Conns initializes AWS SDK for Go v2 clients.
@@ -608,121 +607,265 @@ func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
 		if endpoint := c.Endpoints[names.AuditManager]; endpoint != "" {
 			o.EndpointResolver = auditmanager.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.AuditManager); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.AuditManager); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.cloudcontrolClient = cloudcontrol.NewFromConfig(cfg, func(o *cloudcontrol.Options) {
 		if endpoint := c.Endpoints[names.CloudControl]; endpoint != "" {
 			o.EndpointResolver = cloudcontrol.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.CloudControl); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.CloudControl); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.comprehendClient = comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
 		if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
 			o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.Comprehend); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.Comprehend); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.computeoptimizerClient = computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
 		if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
 			o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.ComputeOptimizer); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.ComputeOptimizer); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.fisClient = fis.NewFromConfig(cfg, func(o *fis.Options) {
 		if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
 			o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.FIS); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.FIS); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.healthlakeClient = healthlake.NewFromConfig(cfg, func(o *healthlake.Options) {
 		if endpoint := c.Endpoints[names.HealthLake]; endpoint != "" {
 			o.EndpointResolver = healthlake.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.HealthLake); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.HealthLake); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.ivschatClient = ivschat.NewFromConfig(cfg, func(o *ivschat.Options) {
 		if endpoint := c.Endpoints[names.IVSChat]; endpoint != "" {
 			o.EndpointResolver = ivschat.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.IVSChat); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.IVSChat); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.identitystoreClient = identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
 		if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
 			o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.IdentityStore); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.IdentityStore); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.inspector2Client = inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
 		if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
 			o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.Inspector2); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.Inspector2); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.kendraClient = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
 		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
 			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.Kendra); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.Kendra); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.medialiveClient = medialive.NewFromConfig(cfg, func(o *medialive.Options) {
 		if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
 			o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.MediaLive); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.MediaLive); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.oamClient = oam.NewFromConfig(cfg, func(o *oam.Options) {
 		if endpoint := c.Endpoints[names.ObservabilityAccessManager]; endpoint != "" {
 			o.EndpointResolver = oam.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.ObservabilityAccessManager); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.ObservabilityAccessManager); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.opensearchserverlessClient = opensearchserverless.NewFromConfig(cfg, func(o *opensearchserverless.Options) {
 		if endpoint := c.Endpoints[names.OpenSearchServerless]; endpoint != "" {
 			o.EndpointResolver = opensearchserverless.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.OpenSearchServerless); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.OpenSearchServerless); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.pipesClient = pipes.NewFromConfig(cfg, func(o *pipes.Options) {
 		if endpoint := c.Endpoints[names.Pipes]; endpoint != "" {
 			o.EndpointResolver = pipes.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.Pipes); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.Pipes); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.rbinClient = rbin.NewFromConfig(cfg, func(o *rbin.Options) {
 		if endpoint := c.Endpoints[names.RBin]; endpoint != "" {
 			o.EndpointResolver = rbin.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.RBin); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.RBin); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.resourceexplorer2Client = resourceexplorer2.NewFromConfig(cfg, func(o *resourceexplorer2.Options) {
 		if endpoint := c.Endpoints[names.ResourceExplorer2]; endpoint != "" {
 			o.EndpointResolver = resourceexplorer2.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.ResourceExplorer2); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.ResourceExplorer2); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.rolesanywhereClient = rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
 		if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
 			o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.RolesAnywhere); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.RolesAnywhere); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.sesv2Client = sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
 		if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
 			o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.SESV2); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.SESV2); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.ssmcontactsClient = ssmcontacts.NewFromConfig(cfg, func(o *ssmcontacts.Options) {
 		if endpoint := c.Endpoints[names.SSMContacts]; endpoint != "" {
 			o.EndpointResolver = ssmcontacts.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.SSMContacts); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.SSMContacts); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.ssmincidentsClient = ssmincidents.NewFromConfig(cfg, func(o *ssmincidents.Options) {
 		if endpoint := c.Endpoints[names.SSMIncidents]; endpoint != "" {
 			o.EndpointResolver = ssmincidents.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.SSMIncidents); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.SSMIncidents); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.schedulerClient = scheduler.NewFromConfig(cfg, func(o *scheduler.Options) {
 		if endpoint := c.Endpoints[names.Scheduler]; endpoint != "" {
 			o.EndpointResolver = scheduler.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.Scheduler); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.Scheduler); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.securitylakeClient = securitylake.NewFromConfig(cfg, func(o *securitylake.Options) {
 		if endpoint := c.Endpoints[names.SecurityLake]; endpoint != "" {
 			o.EndpointResolver = securitylake.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.SecurityLake); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.SecurityLake); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.transcribeClient = transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
 		if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
 			o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.Transcribe); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.Transcribe); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 	client.vpclatticeClient = vpclattice.NewFromConfig(cfg, func(o *vpclattice.Options) {
 		if endpoint := c.Endpoints[names.VPCLattice]; endpoint != "" {
 			o.EndpointResolver = vpclattice.EndpointResolverFromURL(endpoint)
 		}
+		if retryer := c.sdkv2Retryer(names.VPCLattice); retryer != nil {
+			o.Retryer = retryer
+		}
+		if apiOption := c.sdkv2ConcurrencyLimit(names.VPCLattice); apiOption != nil {
+			o.APIOptions = append(o.APIOptions, apiOption)
+		}
 	})
 }
 
@@ -733,6 +876,12 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.EC2]; endpoint != "" {
 				o.EndpointResolver = ec2_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			if retryer := c.sdkv2Retryer(names.EC2); retryer != nil {
+				o.Retryer = retryer
+			}
+			if apiOption := c.sdkv2ConcurrencyLimit(names.EC2); apiOption != nil {
+				o.APIOptions = append(o.APIOptions, apiOption)
+			}
 		})
 	})
 	client.lambdaClient.init(&cfg, func() *lambda_sdkv2.Client {
@@ -740,6 +889,12 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.Lambda]; endpoint != "" {
 				o.EndpointResolver = lambda_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			if retryer := c.sdkv2Retryer(names.Lambda); retryer != nil {
+				o.Retryer = retryer
+			}
+			if apiOption := c.sdkv2ConcurrencyLimit(names.Lambda); apiOption != nil {
+				o.APIOptions = append(o.APIOptions, apiOption)
+			}
 		})
 	})
 	client.logsClient.init(&cfg, func() *cloudwatchlogs_sdkv2.Client {
@@ -747,6 +902,12 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.Logs]; endpoint != "" {
 				o.EndpointResolver = cloudwatchlogs_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			if retryer := c.sdkv2Retryer(names.Logs); retryer != nil {
+				o.Retryer = retryer
+			}
+			if apiOption := c.sdkv2ConcurrencyLimit(names.Logs); apiOption != nil {
+				o.APIOptions = append(o.APIOptions, apiOption)
+			}
 		})
 	})
 	client.rdsClient.init(&cfg, func() *rds_sdkv2.Client {
@@ -754,6 +915,12 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.RDS]; endpoint != "" {
 				o.EndpointResolver = rds_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			if retryer := c.sdkv2Retryer(names.RDS); retryer != nil {
+				o.Retryer = retryer
+			}
+			if apiOption := c.sdkv2ConcurrencyLimit(names.RDS); apiOption != nil {
+				o.APIOptions = append(o.APIOptions, apiOption)
+			}
 		})
 	})
 	client.s3controlClient.init(&cfg, func() *s3control_sdkv2.Client {
@@ -761,6 +928,12 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.S3Control]; endpoint != "" {
 				o.EndpointResolver = s3control_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			if retryer := c.sdkv2Retryer(names.S3Control); retryer != nil {
+				o.Retryer = retryer
+			}
+			if apiOption := c.sdkv2ConcurrencyLimit(names.S3Control); apiOption != nil {
+				o.APIOptions = append(o.APIOptions, apiOption)
+			}
 		})
 	})
 	client.ssmClient.init(&cfg, func() *ssm_sdkv2.Client {
@@ -768,6 +941,12 @@ func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
 			if endpoint := c.Endpoints[names.SSM]; endpoint != "" {
 				o.EndpointResolver = ssm_sdkv2.EndpointResolverFromURL(endpoint)
 			}
+			if retryer := c.sdkv2Retryer(names.SSM); retryer != nil {
+				o.Retryer = retryer
+			}
+			if apiOption := c.sdkv2ConcurrencyLimit(names.SSM); apiOption != nil {
+				o.APIOptions = append(o.APIOptions, apiOption)
+			}
 		})
 	})
 }
@@ -9,6 +9,7 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
@@ -50,6 +51,23 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	return v, ok
 }
 
+// FriendlyName returns the resource's friendly name prefixed by its service's name, e.g. "EC2 Subnet".
+func (c *InContext) FriendlyName() string {
+	serviceName, err := names.HumanFriendly(c.ServicePackageName)
+
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := c.ResourceName
+
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
+	return serviceName + " " + resourceName
+}
+
 func NewSessionForRegion(cfg *aws.Config, region, terraformVersion string) (*session.Session, error) {
 	session, err := session.NewSession(cfg)
 
@@ -0,0 +1,36 @@
+package conns
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// GuardrailsConfig contains provider-wide guardrails enforced when resources are planned and created.
+// Required resource tags are configured separately, see tftags.RequiredConfig.
+type GuardrailsConfig struct {
+	DeniedResourceTypes []string // Resource type names that may not be created, e.g. "aws_iam_user"
+}
+
+// Enabled returns whether any guardrails are configured.
+func (c *GuardrailsConfig) Enabled() bool {
+	return c != nil && len(c.DeniedResourceTypes) > 0
+}
+
+// ResourceTypeDenied returns whether the specified resource type may not be created.
+func (c *GuardrailsConfig) ResourceTypeDenied(typeName string) bool {
+	if c == nil {
+		return false
+	}
+
+	return slices.Contains(c.DeniedResourceTypes, typeName)
+}
+
+// ValidateResourceType returns an error if the specified resource type may not be created.
+func (c *GuardrailsConfig) ValidateResourceType(typeName string) error {
+	if c.ResourceTypeDenied(typeName) {
+		return fmt.Errorf("resource type %s is denied by the provider guardrails configuration", typeName)
+	}
+
+	return nil
+}
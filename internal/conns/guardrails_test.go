@@ -0,0 +1,61 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestGuardrailsConfig(t *testing.T) {
+	t.Parallel()
+
+	guardrails := &GuardrailsConfig{
+		DeniedResourceTypes: []string{"aws_iam_user"},
+	}
+
+	testCases := []struct {
+		name        string
+		config      *GuardrailsConfig
+		typeName    string
+		wantEnabled bool
+		wantDenied  bool
+	}{
+		{
+			name:     "no guardrails",
+			typeName: "aws_iam_user",
+		},
+		{
+			name:     "empty guardrails",
+			config:   &GuardrailsConfig{},
+			typeName: "aws_iam_user",
+		},
+		{
+			name:        "denied resource type",
+			config:      guardrails,
+			typeName:    "aws_iam_user",
+			wantEnabled: true,
+			wantDenied:  true,
+		},
+		{
+			name:        "allowed resource type",
+			config:      guardrails,
+			typeName:    "aws_sqs_queue",
+			wantEnabled: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.Enabled(), testCase.wantEnabled; got != want {
+				t.Errorf("Enabled = %v, want %v", got, want)
+			}
+			if got, want := testCase.config.ResourceTypeDenied(testCase.typeName), testCase.wantDenied; got != want {
+				t.Errorf("ResourceTypeDenied = %v, want %v", got, want)
+			}
+			if got, want := testCase.config.ValidateResourceType(testCase.typeName) != nil, testCase.wantDenied; got != want {
+				t.Errorf("ValidateResourceType error = %v, want %v", got, want)
+			}
+		})
+	}
+}
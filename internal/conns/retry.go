@@ -0,0 +1,176 @@
+package conns
+
+import (
+	"context"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// RetryPolicy represents a service-specific API request retry policy.
+// Zero values inherit the provider-level settings.
+type RetryPolicy struct {
+	MaxAttempts int           // Maximum number of attempts for an API request, including the initial request
+	MaxBackoff  time.Duration // Maximum backoff delay between attempts
+}
+
+// retryPolicy returns the effective retry policy for the specified service package.
+func (c *Config) retryPolicy(servicePackageName string) RetryPolicy {
+	var policy RetryPolicy
+
+	// max_retries counts retries, not attempts.
+	if c.MaxRetries > 0 {
+		policy.MaxAttempts = c.MaxRetries + 1
+	}
+
+	if v, ok := c.RetryPolicies[servicePackageName]; ok && v != nil {
+		if v.MaxAttempts > 0 {
+			policy.MaxAttempts = v.MaxAttempts
+		}
+		if v.MaxBackoff > 0 {
+			policy.MaxBackoff = v.MaxBackoff
+		}
+	}
+
+	return policy
+}
+
+// hasRetryPolicy returns whether a retry policy is configured for the specified service package.
+func (c *Config) hasRetryPolicy(servicePackageName string) bool {
+	v, ok := c.RetryPolicies[servicePackageName]
+
+	return ok && v != nil
+}
+
+// newSDKv2Retryer returns a new AWS SDK for Go v2 retryer for the specified service package.
+// An empty service package name returns the provider-level retryer.
+func (c *Config) newSDKv2Retryer(servicePackageName string) aws_sdkv2.Retryer {
+	policy := c.retryPolicy(servicePackageName)
+	standardOptions := func(o *retry_sdkv2.StandardOptions) {
+		if v := policy.MaxAttempts; v > 0 {
+			o.MaxAttempts = v
+		}
+		if v := policy.MaxBackoff; v > 0 {
+			o.MaxBackoff = v
+		}
+	}
+
+	switch c.RetryMode {
+	case aws_sdkv2.RetryModeAdaptive:
+		return retry_sdkv2.NewAdaptiveMode(func(o *retry_sdkv2.AdaptiveModeOptions) {
+			o.StandardOptions = append(o.StandardOptions, standardOptions)
+		})
+	default:
+		return retry_sdkv2.NewStandard(standardOptions)
+	}
+}
+
+// sdkv2Retryer returns the AWS SDK for Go v2 retryer for the specified service package,
+// or nil if the API client should use the retryer from the AWS SDK configuration.
+func (c *Config) sdkv2Retryer(servicePackageName string) aws_sdkv2.Retryer {
+	if !c.hasRetryPolicy(servicePackageName) {
+		return nil
+	}
+
+	return c.newSDKv2Retryer(servicePackageName)
+}
+
+// sdkv1Session returns a copy of the AWS SDK for Go v1 session configured for the specified service package.
+// Any additional configurations are merged in order after the service endpoint and retry configuration.
+// API requests are subject to the service package's concurrency limit, if any.
+func (c *Config) sdkv1Session(sess *session.Session, servicePackageName string, cfgs ...*aws.Config) *session.Session {
+	config := &aws.Config{
+		Endpoint: aws.String(c.Endpoints[servicePackageName]),
+	}
+
+	if c.hasRetryPolicy(servicePackageName) {
+		policy := c.retryPolicy(servicePackageName)
+		retryer := client.DefaultRetryer{
+			// AWS SDK for Go v1 counts retries, not attempts.
+			NumMaxRetries: client.DefaultRetryerMaxNumRetries,
+		}
+
+		if v := policy.MaxAttempts; v > 0 {
+			retryer.NumMaxRetries = v - 1
+		}
+		if v := policy.MaxBackoff; v > 0 {
+			retryer.MaxRetryDelay = v
+			retryer.MaxThrottleDelay = v
+		}
+
+		config = request.WithRetryer(config, retryer)
+	}
+
+	sess = sess.Copy(append([]*aws.Config{config}, cfgs...)...)
+
+	if c.RetryMode == aws_sdkv2.RetryModeAdaptive {
+		addSDKv1AdaptiveRateLimitHandlers(&sess.Handlers)
+	}
+
+	if semaphore, ok := c.semaphores[servicePackageName]; ok {
+		addSDKv1ConcurrencyLimitHandlers(&sess.Handlers, semaphore, servicePackageName)
+	}
+
+	return sess
+}
+
+type attemptTokenReleaseContextKeyType int
+
+var attemptTokenReleaseContextKey attemptTokenReleaseContextKeyType
+
+// addSDKv1AdaptiveRateLimitHandlers adds handlers that apply the AWS SDK for Go v2 adaptive retry mode's
+// client-side rate limiting to AWS SDK for Go v1 API requests.
+// AWS SDK for Go v1 has no native equivalent of adaptive retry mode.
+func addSDKv1AdaptiveRateLimitHandlers(handlers *request.Handlers) {
+	retryer := retry_sdkv2.NewAdaptiveMode(func(o *retry_sdkv2.AdaptiveModeOptions) {
+		o.Throttles = append(o.Throttles, retry_sdkv2.IsErrorThrottleFunc(func(err error) aws_sdkv2.Ternary {
+			if request.IsErrorThrottle(err) {
+				return aws_sdkv2.TrueTernary
+			}
+
+			return aws_sdkv2.UnknownTernary
+		}))
+	})
+
+	release := func(r *request.Request) {
+		if f, ok := r.Context().Value(attemptTokenReleaseContextKey).(func(error) error); ok {
+			f(r.Error) //nolint:errcheck // Adaptive mode's release function never returns an error.
+		}
+	}
+
+	// Acquire an attempt token before each attempt, sleeping if the client-side rate limit has been reached.
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "tf-aws.AdaptiveRateLimitAcquire",
+		Fn: func(r *request.Request) {
+			f, err := retryer.GetAttemptToken(r.Context())
+
+			if err != nil {
+				r.Error = err
+				r.Retryable = aws.Bool(false)
+
+				return
+			}
+
+			r.SetContext(context.WithValue(r.Context(), attemptTokenReleaseContextKey, f))
+		},
+	})
+	// Failed attempts update the client-side rate limit.
+	handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "tf-aws.AdaptiveRateLimitReleaseOnError",
+		Fn:   release,
+	})
+	// As does the final successful attempt.
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf-aws.AdaptiveRateLimitReleaseOnSuccess",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				release(r)
+			}
+		},
+	})
+}
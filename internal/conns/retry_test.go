@@ -0,0 +1,244 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newThrottlingServer returns a local HTTP stub that responds to every request with a throttling error.
+func newThrottlingServer(t *testing.T, contentType, body string) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(body)) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func newThrottlingServerXML(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+
+	return newThrottlingServer(t, "text/xml", `<ErrorResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>00000000-0000-0000-0000-000000000000</RequestId>
+</ErrorResponse>`)
+}
+
+func newThrottlingServerJSON(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+
+	return newThrottlingServer(t, "application/x-amz-json-1.0", `{"__type":"ThrottlingException","message":"Rate exceeded"}`)
+}
+
+func testRetryConfig(retryMode aws_sdkv2.RetryMode, maxRetries int, retryPolicies map[string]*RetryPolicy, endpoints map[string]string) *Config {
+	return &Config{
+		AccessKey:               "StubAccessKey",
+		Endpoints:               endpoints,
+		MaxRetries:              maxRetries,
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		RetryMode:               retryMode,
+		RetryPolicies:           retryPolicies,
+		SecretKey:               "StubSecretKey",
+		SkipCredsValidation:     true,
+		SkipRequestingAccountId: true,
+	}
+}
+
+func TestRetryPolicySDKv1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		retryMode     aws_sdkv2.RetryMode
+		maxRetries    int
+		retryPolicies map[string]*RetryPolicy
+		wantAttempts  int32
+	}{
+		{
+			name:       "provider max_retries",
+			maxRetries: 2,
+			// AWS SDK for Go v1 counts retries, not attempts.
+			wantAttempts: 3,
+		},
+		{
+			name:       "service retry policy",
+			maxRetries: 25,
+			retryPolicies: map[string]*RetryPolicy{
+				names.IAM: {MaxAttempts: 4, MaxBackoff: time.Millisecond},
+			},
+			wantAttempts: 4,
+		},
+		{
+			name:       "other service retry policy",
+			maxRetries: 1,
+			retryPolicies: map[string]*RetryPolicy{
+				names.Organizations: {MaxAttempts: 10, MaxBackoff: time.Millisecond},
+			},
+			wantAttempts: 2,
+		},
+		{
+			name:       "adaptive retry mode",
+			retryMode:  aws_sdkv2.RetryModeAdaptive,
+			maxRetries: 25,
+			retryPolicies: map[string]*RetryPolicy{
+				names.IAM: {MaxAttempts: 2, MaxBackoff: time.Millisecond},
+			},
+			wantAttempts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server, attempts := newThrottlingServerXML(t)
+			config := testRetryConfig(testCase.retryMode, testCase.maxRetries, testCase.retryPolicies, map[string]string{
+				names.IAM: server.URL,
+			})
+
+			client, diags := config.ConfigureProvider(ctx, new(AWSClient))
+
+			if diags.HasError() {
+				t.Fatalf("configuring provider: %v", diags)
+			}
+
+			_, err := client.IAMConn().GetUserWithContext(ctx, &iam.GetUserInput{})
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := atomic.LoadInt32(attempts), testCase.wantAttempts; got != want {
+				t.Errorf("got %d attempts, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicySDKv2(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		retryMode     aws_sdkv2.RetryMode
+		maxRetries    int
+		retryPolicies map[string]*RetryPolicy
+		wantAttempts  int32
+	}{
+		{
+			name:         "standard retry mode",
+			retryMode:    aws_sdkv2.RetryModeStandard,
+			maxRetries:   2,
+			wantAttempts: 3,
+		},
+		{
+			name:       "service retry policy",
+			maxRetries: 25,
+			retryPolicies: map[string]*RetryPolicy{
+				names.ComputeOptimizer: {MaxAttempts: 3, MaxBackoff: time.Millisecond},
+			},
+			wantAttempts: 3,
+		},
+		{
+			name:       "adaptive retry mode",
+			retryMode:  aws_sdkv2.RetryModeAdaptive,
+			maxRetries: 25,
+			retryPolicies: map[string]*RetryPolicy{
+				names.ComputeOptimizer: {MaxAttempts: 2, MaxBackoff: time.Millisecond},
+			},
+			wantAttempts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server, attempts := newThrottlingServerJSON(t)
+			config := testRetryConfig(testCase.retryMode, testCase.maxRetries, testCase.retryPolicies, map[string]string{
+				names.ComputeOptimizer: server.URL,
+			})
+
+			client, diags := config.ConfigureProvider(ctx, new(AWSClient))
+
+			if diags.HasError() {
+				t.Fatalf("configuring provider: %v", diags)
+			}
+
+			_, err := client.ComputeOptimizerClient().GetEnrollmentStatus(ctx, &computeoptimizer.GetEnrollmentStatusInput{})
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := atomic.LoadInt32(attempts), testCase.wantAttempts; got != want {
+				t.Errorf("got %d attempts, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		MaxRetries: 25,
+		RetryPolicies: map[string]*RetryPolicy{
+			names.IAM:     {MaxAttempts: 5},
+			names.Route53: {MaxBackoff: 30 * time.Second},
+		},
+	}
+
+	testCases := []struct {
+		servicePackageName string
+		expected           RetryPolicy
+	}{
+		{
+			servicePackageName: names.IAM,
+			expected:           RetryPolicy{MaxAttempts: 5},
+		},
+		{
+			servicePackageName: names.Route53,
+			expected:           RetryPolicy{MaxAttempts: 26, MaxBackoff: 30 * time.Second},
+		},
+		{
+			servicePackageName: names.Organizations,
+			expected:           RetryPolicy{MaxAttempts: 26},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.servicePackageName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := config.retryPolicy(testCase.servicePackageName), testCase.expected; got != want {
+				t.Errorf("got %+v, expected %+v", got, want)
+			}
+		})
+	}
+}
@@ -72,6 +72,31 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// Maximum number of resource sweepers that run concurrently in each region, default 4.
+	// Sweepers run only after all of their dependencies have completed
+	SweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// If set to a true value, resource sweepers report the resources that would be deleted without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of tag keys or key=value pairs.
+	// Only resources with all of the tags are swept
+	SweepFilterTags = "TF_AWS_SWEEP_FILTER_TAGS"
+
+	// Minimum resource age, e.g. 24h.
+	// Only resources created at least this long ago are swept
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Regular expression matched against resource names, or IDs for resources without names.
+	// Only matching resources are swept
+	SweepNameRegex = "TF_AWS_SWEEP_NAME_REGEX"
+
+	// Path of a file to which a JSON Lines report of swept resources is appended
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -1,16 +1,23 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"strconv"
 	"testing"
+	"time"
 )
 
 // Semaphore can be used to limit concurrent executions. This can be used to work with resources with low quotas
 type Semaphore chan struct{}
 
+// NewSemaphore returns a semaphore that allows up to limit concurrent executions.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
 // InitializeSemaphore initializes a semaphore with a default capacity or overrides it using an environment variable
 // NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
@@ -27,13 +34,30 @@ func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
 }
 
 // Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
+// WaitContext waits for a semaphore before continuing, returning how long it waited.
+// An error is returned if the context is done before the semaphore is acquired.
+func (s Semaphore) WaitContext(ctx context.Context) (time.Duration, error) {
+	select {
+	case s <- struct{}{}:
+		return 0, nil
+	default:
+	}
+
+	start := time.Now()
+
+	select {
+	case s <- struct{}{}:
+		return time.Since(start), nil
+	case <-ctx.Done():
+		return time.Since(start), ctx.Err()
+	}
+}
+
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
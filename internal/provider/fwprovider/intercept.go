@@ -41,6 +41,13 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// A resource plan interceptor is a resource interceptor that is also invoked during the resource's ModifyPlan call.
+// Plan interceptors are invoked Before and After any resource-specific plan modification.
+type resourcePlanInterceptor interface {
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, when, diag.Diagnostics) diag.Diagnostics
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -66,6 +73,19 @@ func (s resourceInterceptors) update() []resourceInterceptorFunc[resource.Update
 	})
 }
 
+// modifyPlan returns a slice of interceptors that run on resource ModifyPlan.
+func (s resourceInterceptors) modifyPlan() []resourcePlanInterceptor {
+	var result []resourcePlanInterceptor
+
+	for _, v := range s {
+		if v, ok := v.(resourcePlanInterceptor); ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 // delete returns a slice of interceptors that run on resource Delete.
 func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
 	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
@@ -170,11 +190,12 @@ type wrappedResource struct {
 	meta             *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, typeName string, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
-		interceptors:     interceptors,
+		// Provider guardrails are enforced before any other interceptors.
+		interceptors: append(resourceInterceptors{guardrailsInterceptor{typeName: typeName}}, interceptors...),
 	}
 }
 
@@ -251,12 +272,44 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := response.Diagnostics
+	// Before interceptors are run first to last.
+	forward := w.interceptors.modifyPlan()
+
+	when := Before
+	for _, v := range forward {
+		diags = v.modifyPlan(ctx, request, response, w.meta, when, diags)
+
+		// Short circuit if any Before interceptor errors.
+		if diags.HasError() {
+			response.Diagnostics = diags
+
+			return
+		}
+	}
+	response.Diagnostics = diags
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 
-		return
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// After interceptors are run last to first.
+	diags = response.Diagnostics
+	when = After
+	for _, v := range slices.Reverse(forward) {
+		diags = v.modifyPlan(ctx, request, response, w.meta, when, diags)
+
+		// Short circuit if any After interceptor errors.
+		if diags.HasError() {
+			break
+		}
 	}
+	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -285,7 +338,65 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	return nil
 }
 
-// tagsInterceptor implements transparent tagging.
+// guardrailsInterceptor enforces the provider's guardrails configuration when a resource is created.
+type guardrailsInterceptor struct {
+	typeName string
+}
+
+func (r guardrailsInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		if err := meta.GuardrailsConfig.ValidateResourceType(r.typeName); err != nil {
+			diags.AddError("Provider Guardrails Violation", err.Error())
+
+			return ctx, diags
+		}
+	}
+
+	return ctx, diags
+}
+
+func (r guardrailsInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r guardrailsInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r guardrailsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// modifyPlan enforces the provider's guardrails configuration at plan time, before any resource-specific plan modification.
+func (r guardrailsInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) diag.Diagnostics {
+	if when != Before || meta == nil || !meta.GuardrailsConfig.Enabled() {
+		return diags
+	}
+
+	// Nothing to enforce when the resource is to be destroyed.
+	if request.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	// Only resources that are to be created, including replacements, are checked against the denied resource types.
+	if !request.State.Raw.IsNull() && len(response.RequiresReplace) == 0 {
+		return diags
+	}
+
+	if err := meta.GuardrailsConfig.ValidateResourceType(r.typeName); err != nil {
+		diags.AddError("Provider Guardrails Violation", err.Error())
+	}
+
+	return diags
+}
+
+// tagsInterceptor implements transparent tagging for resources that have opted in
+// and enforces the provider's required_tags configuration for all resources with a tags attribute.
 type tagsInterceptor struct {
 	tags *types.ServicePackageResourceTags
 }
@@ -535,11 +646,92 @@ func (r tagsInterceptor) update(ctx context.Context, request resource.UpdateRequ
 			}
 			// TODO If the only change was to tags it would be nice to not call the resource's U handler.
 		}
+	case After:
+		// Set values for unknowns.
+		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
+		// Computed tags_all include any provider configured default_tags.
+		stateTagsAll := flex.FlattenFrameworkStringValueMapLegacy(ctx, tagsInContext.TagsIn.MustUnwrap().IgnoreAWS().IgnoreConfig(tagsInContext.IgnoreConfig).Map())
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
 	}
 
 	return ctx, diags
 }
 
+// modifyPlan calculates the new value for the `tags_all` attribute
+// and enforces the provider's required_tags configuration, after any resource-specific plan modification.
+func (r tagsInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) diag.Diagnostics {
+	if when != After || meta == nil {
+		return diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	// Only resources with a tags attribute are taggable.
+	if _, ok := request.Plan.Schema.GetAttributes()[names.AttrTags]; !ok {
+		return diags
+	}
+
+	// Nothing to do for resources that have not opted in to transparent tagging if no tags are required.
+	if r.tags == nil && meta.RequiredTagsConfig == nil {
+		return diags
+	}
+
+	var planTags fwtypes.Map
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if planTags.IsUnknown() {
+		if r.tags != nil {
+			diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
+		}
+
+		return diags
+	}
+
+	defaultTagsConfig := meta.DefaultTagsConfig
+	ignoreTagsConfig := meta.IgnoreTagsConfig
+	resourceTags := tftags.New(ctx, planTags)
+
+	// Any provider configured default_tags count towards the required tags.
+	if err := meta.RequiredTagsConfig.Validate(defaultTagsConfig.MergeTags(resourceTags), ignoreTagsConfig); err != nil {
+		resourceName := "resource"
+		if v, ok := conns.FromContext(ctx); ok {
+			resourceName = v.FriendlyName()
+		}
+
+		diags.AddAttributeError(
+			path.Root(names.AttrTags),
+			fmt.Sprintf(`%s does not satisfy the "required_tags" configuration block of the provider`, resourceName),
+			err.Error())
+	}
+
+	if r.tags == nil {
+		return diags
+	}
+
+	if defaultTagsConfig.TagsEqual(resourceTags) {
+		diags.AddError(
+			`"tags" are identical to those in the "default_tags" configuration block of the provider`,
+			"please de-duplicate and try again")
+	}
+
+	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
+	diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+	return diags
+}
+
 func (r tagsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
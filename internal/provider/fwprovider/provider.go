@@ -84,6 +84,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"retry_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+			},
 			"s3_force_path_style": schema.BoolAttribute{
 				Optional:           true,
 				Description:        "Set this to true to enable the request to use path-style addressing,\ni.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\nuse virtual hosted bucket addressing when possible\n(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
@@ -242,6 +246,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"concurrency": schema.SetNestedBlock{
+				Description: "Configuration block with settings to limit the number of concurrent API requests to individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_in_flight": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of the service's API requests that may be in flight at any time.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service whose concurrent API requests are limited. Valid values are the same as the `endpoints` configuration block's arguments.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -258,6 +277,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"guardrails": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with guardrails enforced across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"denied_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that may not be created.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -278,6 +312,46 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"required_tags": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys to require across all resources.",
+						},
+						"value_patterns": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions that resource tag values must match, keyed by tag key.",
+						},
+					},
+				},
+			},
+			"retry_policy": schema.SetNestedBlock{
+				Description: "Configuration block with settings to customize API request retries for individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts, including the initial request, for the service's API requests.",
+						},
+						"max_backoff": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The maximum backoff delay between attempts for the service's API requests. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service whose API request retries are customized. Valid values are the same as the `endpoints` configuration block's arguments.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -395,12 +469,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					errs = multierror.Append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName))
 					continue
 				}
-
-				interceptors = append(interceptors, tagsInterceptor{tags: v.Tags})
 			}
 
+			// Required tags are enforced for all resources with a tags attribute, whether or not they have opted in to transparent tagging.
+			interceptors = append(interceptors, tagsInterceptor{tags: v.Tags})
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, typeName, inner, interceptors)
 			})
 		}
 	}
@@ -2,6 +2,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -91,8 +95,13 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
+		start := time.Now()
 		diags = f(ctx, d, meta)
 
+		if diags.HasError() {
+			diags = retryOnError(ctx, d, meta, why, reverse, start, f, diags)
+		}
+
 		if diags.HasError() {
 			when = OnError
 		} else {
@@ -115,6 +124,108 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	}
 }
 
+// A customizeDiffInterceptor is an interceptor that is also invoked when a resource's plan is calculated.
+type customizeDiffInterceptor interface {
+	interceptor
+	// enabled returns whether the provider configuration requires the interceptor's plan-time checks.
+	enabled(*conns.AWSClient) bool
+	// customizeDiff is invoked before any resource-specific diff customization.
+	customizeDiff(context.Context, *schema.ResourceDiff, any) error
+}
+
+// A retryingInterceptor is an interceptor that classifies the Diagnostics returned from an
+// unsuccessful call to the method in schema and can request that the call be retried.
+type retryingInterceptor interface {
+	interceptor
+	// retryHandler returns whether the unsuccessful call is to be retried.
+	// If a non-nil handler is returned it is invoked in place of the method in schema.
+	retryHandler(context.Context, *schema.ResourceData, any, why, diag.Diagnostics) (schema.ReadContextFunc, bool)
+}
+
+const (
+	retryMinDelay = 1 * time.Second
+	retryMaxDelay = 30 * time.Second
+)
+
+// retryOnError retries an unsuccessful call to the method in schema, as requested by any OnError retrying interceptors,
+// until the call succeeds, the Diagnostics are no longer retryable or the resource's operation timeout expires.
+func retryOnError[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](ctx context.Context, d *schema.ResourceData, meta any, why why, interceptors interceptorItems, start time.Time, f F, diags diag.Diagnostics) diag.Diagnostics {
+	var retrying []retryingInterceptor
+	for _, v := range interceptors {
+		if v.when&OnError != 0 && v.why&why != 0 {
+			if v, ok := v.interceptor.(retryingInterceptor); ok {
+				retrying = append(retrying, v)
+			}
+		}
+	}
+
+	if len(retrying) == 0 {
+		return diags
+	}
+
+	deadline := start.Add(operationTimeout(d, why))
+	id, original := d.Id(), diags
+
+	for delay := retryMinDelay; diags.HasError(); {
+		var handler schema.ReadContextFunc
+		var retry bool
+		for _, v := range retrying {
+			if handler, retry = v.retryHandler(ctx, d, meta, why, diags); retry {
+				break
+			}
+		}
+
+		if !retry || time.Until(deadline) < delay {
+			break
+		}
+
+		tflog.Debug(ctx, "retrying after transient error", map[string]any{
+			"delay": delay.String(),
+			"error": sdkdiag.DiagnosticsError(diags).Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return diags
+		case <-time.After(delay):
+		}
+
+		if handler != nil {
+			diags = handler(ctx, d, meta)
+
+			// A Read handler that cannot find the resource removes it from state.
+			// Report the original error and keep the created resource in state so that it is tainted.
+			if why == Create && !diags.HasError() && d.Id() == "" {
+				d.SetId(id)
+
+				return original
+			}
+		} else {
+			diags = f(ctx, d, meta)
+		}
+
+		if delay *= 2; delay > retryMaxDelay {
+			delay = retryMaxDelay
+		}
+	}
+
+	return diags
+}
+
+// operationTimeout returns the resource's timeout for the specified CRUD operation.
+func operationTimeout(d *schema.ResourceData, why why) time.Duration {
+	switch why {
+	case Create:
+		return d.Timeout(schema.TimeoutCreate)
+	case Update:
+		return d.Timeout(schema.TimeoutUpdate)
+	case Delete:
+		return d.Timeout(schema.TimeoutDelete)
+	default:
+		return d.Timeout(schema.TimeoutRead)
+	}
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
@@ -160,10 +271,36 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	}
 }
 
+// CustomizeDiff returns a CustomizeDiffFunc that runs any plan-time interceptors before the specified function, which may be nil.
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		// Only run the plan-time interceptors that the provider configuration requires.
+		var interceptors []customizeDiffInterceptor
+		if client, ok := meta.(*conns.AWSClient); ok {
+			for _, v := range r.interceptors {
+				if v, ok := v.interceptor.(customizeDiffInterceptor); ok && v.enabled(client) {
+					interceptors = append(interceptors, v)
+				}
+			}
+		}
+
+		if len(interceptors) == 0 && f == nil {
+			return nil
+		}
+
 		ctx = r.bootstrapContext(ctx, meta)
 
+		// Plan-time interceptors are run first to last, before any resource-specific diff customization.
+		for _, v := range interceptors {
+			if err := v.customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -176,7 +313,8 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
-// tagsInterceptor implements transparent tagging.
+// tagsInterceptor implements transparent tagging for resources that have opted in
+// and enforces the provider's required_tags configuration for all resources with a tags attribute.
 type tagsInterceptor struct {
 	tags *types.ServicePackageResourceTags
 }
@@ -337,3 +475,151 @@ func (r tagsInterceptor) run(ctx context.Context, d *schema.ResourceData, meta a
 
 	return ctx, diags
 }
+
+func (r tagsInterceptor) enabled(client *conns.AWSClient) bool {
+	return client.RequiredTagsConfig != nil
+}
+
+// customizeDiff enforces the provider's required_tags configuration at plan time.
+func (r tagsInterceptor) customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown(names.AttrTags) {
+		return nil
+	}
+
+	client := meta.(*conns.AWSClient)
+	// Any provider configured default_tags count towards the required tags.
+	tags := client.DefaultTagsConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+
+	if err := client.RequiredTagsConfig.Validate(tags, client.IgnoreTagsConfig); err != nil {
+		resourceName := "resource"
+		if v, ok := conns.FromContext(ctx); ok {
+			resourceName = v.FriendlyName()
+		}
+
+		return fmt.Errorf(`%s does not satisfy the "required_tags" configuration block of the provider: %w`, resourceName, err)
+	}
+
+	return nil
+}
+
+// guardrailsInterceptor enforces the provider's guardrails configuration when a resource is created.
+type guardrailsInterceptor struct {
+	typeName string
+	// forceNewKeys are the resource's top-level attributes whose change replaces the resource.
+	forceNewKeys []string
+}
+
+func (r guardrailsInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Create:
+			if err := client.GuardrailsConfig.ValidateResourceType(r.typeName); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+func (r guardrailsInterceptor) enabled(client *conns.AWSClient) bool {
+	return client.GuardrailsConfig.Enabled()
+}
+
+// customizeDiff enforces the provider's guardrails configuration at plan time.
+func (r guardrailsInterceptor) customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	// Only resources that are to be created, including replacements, are checked against the denied resource types.
+	if !r.creating(d) {
+		return nil
+	}
+
+	return meta.(*conns.AWSClient).GuardrailsConfig.ValidateResourceType(r.typeName)
+}
+
+// creating returns whether the resource is planned to be created or replaced.
+func (r guardrailsInterceptor) creating(d *schema.ResourceDiff) bool {
+	if d.Id() == "" || d.GetRawState().IsNull() {
+		return true
+	}
+
+	// Terraform plans a replacement again as a create, so a change to a nested ForceNew attribute
+	// is caught then. Checking the top-level ForceNew attributes reports the violation in the first plan.
+	for _, key := range r.forceNewKeys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	// Error codes returned by AWS APIs when requests are throttled.
+	throttlingErrorRegexp = regexp.MustCompile(`\b(BandwidthLimitExceeded|EC2ThrottledException|PriorRequestNotComplete|ProvisionedThroughputExceededException|RequestLimitExceeded|RequestThrottled|RequestThrottledException|SlowDown|ThrottledException|Throttling|ThrottlingException|TooManyRequestsException):`)
+	// Errors returned when a just-created resource is not yet visible.
+	notFoundErrorRegexp = regexp.MustCompile(`couldn't find resource|empty result|\b\w*NotFound\w*:|\bNoSuch\w+:`)
+	// Errors returned when a just-created IAM principal has not yet propagated.
+	iamPropagationErrorRegexp = regexp.MustCompile(`(?is)\bInvalidParameterValue(Exception)?:.*(\biam\b|\brole\b|principal|assume|instance profile)|\bInvalidRequestException:.*unable to assume role`)
+)
+
+// retryInterceptor implements transparent retry of CRUD handlers that fail with transient errors:
+// throttling, eventual consistency of just-created resources and propagation of just-created IAM principals.
+type retryInterceptor struct {
+	// resource is the resource whose handlers are retried.
+	// Create handlers are assumed to tail call the Read handler, so once a resource has been created
+	// a transient error reading it is retried by calling the (wrapped) Read handler rather than re-creating the resource.
+	resource *schema.Resource
+}
+
+func (r retryInterceptor) run(ctx context.Context, d *schema.ResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	// Unsuccessful calls have already been retried by the time OnError interceptors are run.
+	return ctx, diags
+}
+
+func (r retryInterceptor) retryHandler(ctx context.Context, d *schema.ResourceData, meta any, why why, diags diag.Diagnostics) (schema.ReadContextFunc, bool) {
+	id := d.Id()
+	// readTransient is set only if every error is a transient failure of the resource's own Read handler.
+	var throttled, iamPropagation bool
+	readTransient := true
+	for _, v := range sdkdiag.Errors(diags) {
+		s := sdkdiag.DiagnosticString(v)
+		t := throttlingErrorRegexp.MatchString(s)
+		throttled = throttled || t
+		iamPropagation = iamPropagation || iamPropagationErrorRegexp.MatchString(s)
+		readTransient = readTransient && isReadError(v, id) && (t || notFoundErrorRegexp.MatchString(s))
+	}
+
+	switch why {
+	case Create:
+		if id == "" {
+			// The resource has not been created.
+			return nil, throttled || iamPropagation
+		}
+
+		// The resource has been created but could not be read.
+		// Errors from any other API call made after the resource was created, e.g. waiting for it
+		// or configuring a related resource, are not retried.
+		if r.resource != nil && r.resource.ReadWithoutTimeout != nil && readTransient {
+			return r.resource.ReadWithoutTimeout, true
+		}
+	case Update:
+		return nil, throttled || iamPropagation
+	case Read, Delete:
+		return nil, throttled
+	}
+
+	return nil, false
+}
+
+// isReadError returns whether the Diagnostic is an error reading the resource with the specified ID,
+// e.g. "reading Thing (id-1): couldn't find resource".
+func isReadError(d diag.Diagnostic, id string) bool {
+	return strings.HasPrefix(d.Summary, "reading ") && strings.Contains(d.Summary, "("+id+")")
+}
@@ -90,3 +90,226 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestRetryInterceptorRetryHandler(t *testing.T) {
+	t.Parallel()
+
+	var read schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return nil
+	}
+	r := retryInterceptor{resource: &schema.Resource{ReadWithoutTimeout: read}}
+
+	testCases := []struct {
+		name        string
+		why         why
+		id          string
+		diags       diag.Diagnostics
+		wantRetry   bool
+		wantHandler bool
+	}{
+		{
+			name:      "create throttled",
+			why:       Create,
+			diags:     diag.Errorf("creating Thing: ThrottlingException: Rate exceeded"),
+			wantRetry: true,
+		},
+		{
+			name:      "create IAM propagation",
+			why:       Create,
+			diags:     diag.Errorf("creating Lambda Function (test): InvalidParameterValueException: The role defined for the function cannot be assumed by Lambda."),
+			wantRetry: true,
+		},
+		{
+			name:      "create IAM propagation InvalidRequestException",
+			why:       Create,
+			diags:     diag.Errorf("creating IoT Events Detector Model (test): InvalidRequestException: Unable to assume role, role ARN: arn:aws:iam::123456789012:role/test"),
+			wantRetry: true,
+		},
+		{
+			name:  "create other InvalidRequestException",
+			why:   Create,
+			diags: diag.Errorf("creating IoT Events Detector Model (test): InvalidRequestException: Detector model definition is invalid"),
+		},
+		{
+			name:  "create other InvalidParameterValue",
+			why:   Create,
+			diags: diag.Errorf("creating Thing: InvalidParameterValue: Value (-1) for parameter size is invalid"),
+		},
+		{
+			name:  "create not found before created",
+			why:   Create,
+			diags: diag.Errorf("creating Thing: ResourceNotFoundException: Parent not found"),
+		},
+		{
+			name:        "create not found after created",
+			why:         Create,
+			id:          "id-1",
+			diags:       diag.Errorf("reading Thing (id-1): couldn't find resource"),
+			wantRetry:   true,
+			wantHandler: true,
+		},
+		{
+			name:        "create throttled reading after created",
+			why:         Create,
+			id:          "id-1",
+			diags:       diag.Errorf("reading Thing (id-1): ThrottlingException: Rate exceeded"),
+			wantRetry:   true,
+			wantHandler: true,
+		},
+		{
+			name:  "create waiter not found after created",
+			why:   Create,
+			id:    "id-1",
+			diags: diag.Errorf("waiting for Thing (id-1) create: couldn't find resource"),
+		},
+		{
+			name:  "create related resource not found after created",
+			why:   Create,
+			id:    "id-1",
+			diags: diag.Errorf("putting Thing (id-1) policy: NoSuchEntity: The role with name test cannot be found."),
+		},
+		{
+			name:  "create related resource throttled after created",
+			why:   Create,
+			id:    "id-1",
+			diags: diag.Errorf("adding tags to Thing (id-1): ThrottlingException: Rate exceeded"),
+		},
+		{
+			name: "create not found reading other resource after created",
+			why:  Create,
+			id:   "id-1",
+			diags: diag.Diagnostics{
+				diag.Diagnostic{Severity: diag.Error, Summary: "reading Thing (id-1): couldn't find resource"},
+				diag.Diagnostic{Severity: diag.Error, Summary: "reading Other Thing (id-2): couldn't find resource"},
+			},
+		},
+		{
+			name:  "create IAM propagation after created",
+			why:   Create,
+			id:    "id-1",
+			diags: diag.Errorf("modifying Thing (id-1): InvalidParameterValue: Invalid IAM Instance Profile name"),
+		},
+		{
+			name:      "read throttled",
+			why:       Read,
+			id:        "id-1",
+			diags:     diag.Errorf("reading Thing (id-1): operation error Thing: GetThing, https response error StatusCode: 400, RequestID: 1, api error Throttling: Rate exceeded"),
+			wantRetry: true,
+		},
+		{
+			name:  "read not found",
+			why:   Read,
+			id:    "id-1",
+			diags: diag.Errorf("reading Thing (id-1): couldn't find resource"),
+		},
+		{
+			name:      "update IAM propagation",
+			why:       Update,
+			id:        "id-1",
+			diags:     diag.Errorf("updating Thing (id-1): InvalidParameterValue: Value for parameter principal is invalid"),
+			wantRetry: true,
+		},
+		{
+			name:      "delete throttled",
+			why:       Delete,
+			id:        "id-1",
+			diags:     diag.Errorf("deleting Thing (id-1): RequestLimitExceeded: Request limit exceeded."),
+			wantRetry: true,
+		},
+		{
+			name:  "delete other error",
+			why:   Delete,
+			id:    "id-1",
+			diags: diag.Errorf("deleting Thing (id-1): DependencyViolation: resource has a dependent object"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+			d.SetId(testCase.id)
+
+			handler, retry := r.retryHandler(context.Background(), d, 42, testCase.why, testCase.diags)
+
+			if got, want := retry, testCase.wantRetry; got != want {
+				t.Errorf("retry = %v, want %v", got, want)
+			}
+			if got, want := handler != nil, testCase.wantHandler; got != want {
+				t.Errorf("handler != nil = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestInterceptedHandlerRetry(t *testing.T) {
+	t.Parallel()
+
+	interceptors := interceptorItems{
+		{
+			when:        OnError,
+			why:         AllOps,
+			interceptor: retryInterceptor{},
+		},
+	}
+
+	var attempts int
+	var read schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if attempts++; attempts == 1 {
+			return sdkdiag.AppendErrorf(diags, "reading Thing (%s): ThrottlingException: Rate exceeded", d.Id())
+		}
+		return diags
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("id-1")
+
+	diags := interceptedHandler(bootstrapContext, interceptors, read, Read)(context.Background(), d, 42)
+	if diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+	if got, want := attempts, 2; got != want {
+		t.Errorf("attempts = %v, want %v", got, want)
+	}
+}
+
+func TestInterceptedHandlerRetryCreateReadClearsID(t *testing.T) {
+	t.Parallel()
+
+	var read schema.ReadContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// The resource cannot be found and is removed from state.
+		d.SetId("")
+		return nil
+	}
+	var create schema.CreateContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		d.SetId("id-1")
+		return sdkdiag.AppendErrorf(diags, "reading Thing (%s): couldn't find resource", d.Id())
+	}
+	interceptors := interceptorItems{
+		{
+			when:        OnError,
+			why:         AllOps,
+			interceptor: retryInterceptor{resource: &schema.Resource{ReadWithoutTimeout: read}},
+		},
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+
+	diags := interceptedHandler(bootstrapContext, interceptors, create, Create)(context.Background(), d, 42)
+	if !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+	if got, want := d.Id(), "id-1"; got != want {
+		t.Errorf("ID = %v, want %v", got, want)
+	}
+}
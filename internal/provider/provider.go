@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
@@ -45,6 +46,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"concurrency":                   concurrencySchema(),
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -88,6 +90,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"allowed_account_ids"},
 				Set:           schema.HashString,
 			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with guardrails enforced across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"denied_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource types that may not be created.",
+						},
+					},
+				},
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -143,6 +162,40 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys to require across all resources.",
+						},
+						"value_patterns": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Regular expressions that resource tag values must match, keyed by tag key.",
+						},
+					},
+				},
+			},
+			"retry_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(aws_sdkv2.RetryModeStandard),
+					string(aws_sdkv2.RetryModeAdaptive),
+				}, false),
+				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
+					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+			},
+			"retry_policy": retryPolicySchema(),
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -286,7 +339,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        OnError,
+					why:         Read,
+					interceptor: retryInterceptor{},
+				},
+			}
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -337,7 +396,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			var forceNewKeys []string
+			for k, v := range r.Schema {
+				if v.ForceNew {
+					forceNewKeys = append(forceNewKeys, k)
+				}
+			}
+			guardrails := guardrailsInterceptor{
+				typeName:     typeName,
+				forceNewKeys: forceNewKeys,
+			}
+			interceptors := interceptorItems{
+				{
+					when:        Before,
+					why:         Create,
+					interceptor: guardrails,
+				},
+				// The retry interceptor calls the resource's Read handler as wrapped below.
+				{
+					when:        OnError,
+					why:         AllOps,
+					interceptor: retryInterceptor{resource: r},
+				},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -360,7 +441,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					errs = multierror.Append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName))
 					continue
 				}
+			}
 
+			// Required tags are enforced for all resources with a tags attribute, whether or not they have opted in to transparent tagging.
+			if _, ok := r.Schema[names.AttrTags]; ok {
 				interceptors = append(interceptors, interceptorItem{
 					when:        Before | After,
 					why:         Create | Read | Update,
@@ -390,9 +474,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
-			if v := r.CustomizeDiff; v != nil {
-				r.CustomizeDiff = rs.CustomizeDiff(v)
-			}
+			// Provider guardrails and required tags are enforced at plan time before any resource-specific diff customization.
+			r.CustomizeDiff = rs.CustomizeDiff(r.CustomizeDiff)
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
 					stateUpgrader.Upgrade = rs.StateUpgrade(v)
@@ -495,6 +578,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("guardrails"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.GuardrailsConfig = expandGuardrails(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -503,6 +590,40 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		requiredTagsConfig, err := expandRequiredTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RequiredTagsConfig = requiredTagsConfig
+	}
+
+	if v, ok := d.GetOk("concurrency"); ok && v.(*schema.Set).Len() > 0 {
+		concurrencyLimits, err := expandConcurrencyLimits(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.ConcurrencyLimits = concurrencyLimits
+	}
+
+	if v, ok := d.GetOk("retry_mode"); ok {
+		config.RetryMode = aws_sdkv2.RetryMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("retry_policy"); ok && v.(*schema.Set).Len() > 0 {
+		retryPolicies, err := expandRetryPolicies(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RetryPolicies = retryPolicies
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -673,6 +794,29 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func concurrencySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration block with settings to limit the number of concurrent API requests to individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_in_flight": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of the service's API requests that may be in flight at any time.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service whose concurrent API requests are limited. Valid values are the same as the `endpoints` configuration block's arguments.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -694,6 +838,35 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func retryPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration block with settings to customize API request retries for individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of attempts, including the initial request, for the service's API requests.",
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+					Description:  "The maximum backoff delay between attempts for the service's API requests. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service whose API request retries are customized. Valid values are the same as the `endpoints` configuration block's arguments.",
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -798,6 +971,20 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandGuardrails(_ context.Context, tfMap map[string]interface{}) *conns.GuardrailsConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	guardrailsConfig := &conns.GuardrailsConfig{}
+
+	if v, ok := tfMap["denied_resource_types"].(*schema.Set); ok {
+		guardrailsConfig.DeniedResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	return guardrailsConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -816,6 +1003,109 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRequiredTags(_ context.Context, tfMap map[string]interface{}) (*tftags.RequiredConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+
+	if v, ok := tfMap["keys"].(*schema.Set); ok {
+		requiredConfig.Keys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["value_patterns"].(map[string]interface{}); ok && len(v) > 0 {
+		requiredConfig.ValuePatterns = make(map[string]*regexp.Regexp, len(v))
+
+		for key, pattern := range v {
+			re, err := regexp.Compile(pattern.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid required tag (%s) value pattern: %w", key, err)
+			}
+
+			requiredConfig.ValuePatterns[key] = re
+		}
+	}
+
+	return requiredConfig, nil
+}
+
+func expandRetryPolicies(_ context.Context, tfList []interface{}) (map[string]*conns.RetryPolicy, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	retryPolicies := make(map[string]*conns.RetryPolicy)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign retry policy (%s): %w", alias, err)
+		}
+
+		if _, ok := retryPolicies[pkg]; ok {
+			return nil, fmt.Errorf("duplicate retry policy: %s", alias)
+		}
+
+		retryPolicy := &conns.RetryPolicy{}
+
+		if v, ok := tfMap["max_attempts"].(int); ok && v != 0 {
+			retryPolicy.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			retryPolicy.MaxBackoff = duration
+		}
+
+		retryPolicies[pkg] = retryPolicy
+	}
+
+	return retryPolicies, nil
+}
+
+func expandConcurrencyLimits(_ context.Context, tfList []interface{}) (map[string]int, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	concurrencyLimits := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign concurrency limit (%s): %w", alias, err)
+		}
+
+		if _, ok := concurrencyLimits[pkg]; ok {
+			return nil, fmt.Errorf("duplicate concurrency limit: %s", alias)
+		}
+
+		if v, ok := tfMap["max_in_flight"].(int); ok && v > 0 {
+			concurrencyLimits[pkg] = v
+		}
+	}
+
+	return concurrencyLimits, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -184,6 +185,115 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandRetryPolicies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	results, err := expandRetryPolicies(ctx, []interface{}{
+		map[string]interface{}{
+			"service":      "iam",
+			"max_attempts": 10,
+			"max_backoff":  "",
+		},
+		map[string]interface{}{
+			"service":      "route53",
+			"max_attempts": 0,
+			"max_backoff":  "30s",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if a, e := len(results), 2; a != e {
+		t.Fatalf("Expected %d retry policies, got %d", e, a)
+	}
+
+	if v := results[names.IAM]; v == nil || v.MaxAttempts != 10 || v.MaxBackoff != 0 {
+		t.Errorf("Unexpected retry policy[%s]: %+v", names.IAM, v)
+	}
+
+	if v := results[names.Route53]; v == nil || v.MaxAttempts != 0 || v.MaxBackoff != 30*time.Second {
+		t.Errorf("Unexpected retry policy[%s]: %+v", names.Route53, v)
+	}
+
+	_, err = expandRetryPolicies(ctx, []interface{}{
+		map[string]interface{}{
+			"service": "iam",
+		},
+		map[string]interface{}{
+			"service": "iam",
+		},
+	})
+	if err == nil {
+		t.Error("Expected error for duplicate retry policies, got none")
+	}
+
+	_, err = expandRetryPolicies(ctx, []interface{}{
+		map[string]interface{}{
+			"service": "notaservice",
+		},
+	})
+	if err == nil {
+		t.Error("Expected error for unknown service, got none")
+	}
+}
+
+func TestExpandConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	results, err := expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service":       "acm",
+			"max_in_flight": 2,
+		},
+		map[string]interface{}{
+			"service":       "route53",
+			"max_in_flight": 1,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if a, e := len(results), 2; a != e {
+		t.Fatalf("Expected %d concurrency limits, got %d", e, a)
+	}
+
+	if a, e := results[names.ACM], 2; a != e {
+		t.Errorf("Unexpected concurrency limit[%s]: %d", names.ACM, a)
+	}
+
+	if a, e := results[names.Route53], 1; a != e {
+		t.Errorf("Unexpected concurrency limit[%s]: %d", names.Route53, a)
+	}
+
+	_, err = expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service":       "acm",
+			"max_in_flight": 1,
+		},
+		map[string]interface{}{
+			"service":       "acm",
+			"max_in_flight": 2,
+		},
+	})
+	if err == nil {
+		t.Error("Expected error for duplicate concurrency limits, got none")
+	}
+
+	_, err = expandConcurrencyLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"service":       "notaservice",
+			"max_in_flight": 1,
+		},
+	})
+	if err == nil {
+		t.Error("Expected error for unknown service, got none")
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
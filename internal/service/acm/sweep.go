@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -84,8 +84,12 @@ func sweepCertificates(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting ACM certificate: %s", arn)
-			_, err = conn.DeleteCertificateWithContext(ctx, &acm.DeleteCertificateInput{
-				CertificateArn: aws.String(arn),
+			err = sweep.DeleteFunc(ctx, client, "aws_acm_certificate", arn, func() error {
+				_, err := conn.DeleteCertificateWithContext(ctx, &acm.DeleteCertificateInput{
+					CertificateArn: aws.String(arn),
+				})
+
+				return err
 			})
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting ACM certificate (%s): %w", arn, err)
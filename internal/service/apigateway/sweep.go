@@ -19,27 +19,27 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
 		Name: "aws_api_gateway_api_key",
 		F:    sweepAPIKeys,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
@@ -68,15 +68,17 @@ func sweepRestAPIs(region string) error {
 			}
 			log.Printf("[INFO] Deleting API Gateway REST API: %s", input)
 			// TooManyRequestsException: Too Many Requests can take over a minute to resolve itself
-			err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
-				_, err := conn.DeleteRestApiWithContext(ctx, input)
-				if err != nil {
-					if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeTooManyRequestsException) {
-						return retry.RetryableError(err)
+			err := sweep.DeleteFunc(ctx, client, "aws_api_gateway_rest_api", aws.StringValue(item.Id), func() error {
+				return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+					_, err := conn.DeleteRestApiWithContext(ctx, input)
+					if err != nil {
+						if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeTooManyRequestsException) {
+							return retry.RetryableError(err)
+						}
+						return retry.NonRetryableError(err)
 					}
-					return retry.NonRetryableError(err)
-				}
-				return nil
+					return nil
+				})
 			})
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete API Gateway REST API %s: %s", *item.Name, err)
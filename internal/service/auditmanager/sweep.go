@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment",
 		F:    sweepAssessments,
 		Dependencies: []string{
@@ -28,23 +28,23 @@ func init() {
 			"aws_s3_bucket",
 		},
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_delegation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment_delegation", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment_delegation",
 		F:    sweepAssessmentDelegations,
 	})
-	resource.AddTestSweepers("aws_auditmanager_assessment_report", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_assessment_report", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment_report",
 		F:    sweepAssessmentReports,
 	})
-	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
 		Name: "aws_auditmanager_control",
 		F:    sweepControls,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
 		Name: "aws_auditmanager_framework",
 		F:    sweepFrameworks,
 	})
-	resource.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
 		Name: "aws_auditmanager_framework_share",
 		F:    sweepFrameworkShares,
 	})
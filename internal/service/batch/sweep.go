@@ -4,6 +4,7 @@
 package batch
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,11 +16,12 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -27,7 +29,7 @@ func init() {
 		F: sweepComputeEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    sweepJobDefinitions,
 		Dependencies: []string{
@@ -35,12 +37,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
 
-	resource.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
 		Name: "aws_batch_scheduling_policy",
 		F:    sweepSchedulingPolicies,
 		Dependencies: []string{
@@ -70,6 +72,10 @@ func sweepComputeEnvironments(region string) error {
 		for _, v := range page.ComputeEnvironments {
 			name := aws.StringValue(v.ComputeEnvironmentName)
 
+			r := ResourceComputeEnvironment()
+			d := r.Data(nil)
+			d.SetId(name)
+
 			// Reference: https://aws.amazon.com/premiumsupport/knowledge-center/batch-invalid-compute-environment/
 			//
 			// When a Compute Environment becomes INVALID, it is typically because the associated
@@ -82,63 +88,22 @@ func sweepComputeEnvironments(region string) error {
 			// To save writing much more logic around IAM Role deletion, we allow the
 			// aws_iam_role sweeper to handle cleaning these up.
 			if aws.StringValue(v.Status) == batch.CEStatusInvalid {
-				// Reusing the IAM Role name to prevent collisions and inventing a naming scheme.
-				serviceRoleARN, err := arn.Parse(aws.StringValue(v.ServiceRole))
+				v := v
+				err := sweep.DeleteFunc(ctx, client, "aws_batch_compute_environment", name, func() error {
+					if err := recreateComputeEnvironmentServiceRole(ctx, iamconn, region, v); err != nil {
+						return err
+					}
 
-				if err != nil {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error parsing Batch Compute Environment (%s) Service Role ARN (%s): %w", name, aws.StringValue(v.ServiceRole), err))
-					continue
-				}
-
-				servicePrincipal := fmt.Sprintf("%s.%s", batch.EndpointsID, sweep.PartitionDNSSuffix(region))
-				serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
-				serviceRolePolicyARN := arn.ARN{
-					AccountID: "aws",
-					Partition: sweep.Partition(region),
-					Resource:  "policy/service-role/AWSBatchServiceRole",
-					Service:   iam.ServiceName,
-				}.String()
-
-				iamCreateRoleInput := &iam.CreateRoleInput{
-					AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
-					RoleName:                 aws.String(serviceRoleName),
-				}
-
-				_, err = iamconn.CreateRoleWithContext(ctx, iamCreateRoleInput)
+					return sdkdiag.DiagnosticsError(r.DeleteWithoutTimeout(ctx, d, client))
+				})
 
 				if err != nil {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err))
-					continue
-				}
-
-				iamGetRoleInput := &iam.GetRoleInput{
-					RoleName: aws.String(serviceRoleName),
+					sweeperErrs = multierror.Append(sweeperErrs, err)
 				}
 
-				err = iamconn.WaitUntilRoleExistsWithContext(ctx, iamGetRoleInput)
-
-				if err != nil {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err))
-					continue
-				}
-
-				iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
-					PolicyArn: aws.String(serviceRolePolicyARN),
-					RoleName:  aws.String(serviceRoleName),
-				}
-
-				_, err = iamconn.AttachRolePolicyWithContext(ctx, iamAttachRolePolicyInput)
-
-				if err != nil {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRolePolicyARN, serviceRoleName, name, err))
-					continue
-				}
+				continue
 			}
 
-			r := ResourceComputeEnvironment()
-			d := r.Data(nil)
-			d.SetId(name)
-
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
@@ -167,6 +132,61 @@ func sweepComputeEnvironments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+// recreateComputeEnvironmentServiceRole recreates the missing IAM service role of an INVALID Batch compute environment so that it can be deleted.
+func recreateComputeEnvironmentServiceRole(ctx context.Context, conn *iam.IAM, region string, v *batch.ComputeEnvironmentDetail) error {
+	name := aws.StringValue(v.ComputeEnvironmentName)
+
+	// Reusing the IAM Role name to prevent collisions and inventing a naming scheme.
+	serviceRoleARN, err := arn.Parse(aws.StringValue(v.ServiceRole))
+
+	if err != nil {
+		return fmt.Errorf("error parsing Batch Compute Environment (%s) Service Role ARN (%s): %w", name, aws.StringValue(v.ServiceRole), err)
+	}
+
+	servicePrincipal := fmt.Sprintf("%s.%s", batch.EndpointsID, sweep.PartitionDNSSuffix(region))
+	serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
+	serviceRolePolicyARN := arn.ARN{
+		AccountID: "aws",
+		Partition: sweep.Partition(region),
+		Resource:  "policy/service-role/AWSBatchServiceRole",
+		Service:   iam.ServiceName,
+	}.String()
+
+	iamCreateRoleInput := &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
+		RoleName:                 aws.String(serviceRoleName),
+	}
+
+	_, err = conn.CreateRoleWithContext(ctx, iamCreateRoleInput)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
+	}
+
+	iamGetRoleInput := &iam.GetRoleInput{
+		RoleName: aws.String(serviceRoleName),
+	}
+
+	err = conn.WaitUntilRoleExistsWithContext(ctx, iamGetRoleInput)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
+	}
+
+	iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
+		PolicyArn: aws.String(serviceRolePolicyARN),
+		RoleName:  aws.String(serviceRoleName),
+	}
+
+	_, err = conn.AttachRolePolicyWithContext(ctx, iamAttachRolePolicyInput)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRolePolicyARN, serviceRoleName, name, err)
+	}
+
+	return nil
+}
+
 func sweepJobDefinitions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
@@ -0,0 +1,9 @@
+package chimesdkmediapipelines
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,823 @@
+package chimesdkmediapipelines
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chimesdkmediapipelines"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_chimesdkmediapipelines_media_insights_pipeline_configuration", name="Media Insights Pipeline Configuration")
+// @Tags(identifierAttribute="arn")
+func ResourceMediaInsightsPipelineConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMediaInsightsPipelineConfigurationCreate,
+		ReadWithoutTimeout:   resourceMediaInsightsPipelineConfigurationRead,
+		UpdateWithoutTimeout: resourceMediaInsightsPipelineConfigurationUpdate,
+		DeleteWithoutTimeout: resourceMediaInsightsPipelineConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"elements": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_transcribe_call_analytics_processor_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"call_analytics_stream_categories": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 20,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 200),
+										},
+									},
+									"content_identification_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.ContentType_Values(), false),
+									},
+									"content_redaction_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.ContentType_Values(), false),
+									},
+									"enable_partial_results_stabilization": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"filter_partial_results": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"language_code": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.CallAnalyticsLanguageCode_Values(), false),
+									},
+									"language_model_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+									"partial_results_stability": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.PartialResultsStability_Values(), false),
+									},
+									"pii_entity_types": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 300),
+									},
+									"post_call_analytics_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"content_redaction_output": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.ContentRedactionOutput_Values(), false),
+												},
+												"data_access_role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"output_encryption_kms_key_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"output_location": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"vocabulary_filter_method": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.VocabularyFilterMethod_Values(), false),
+									},
+									"vocabulary_filter_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+									"vocabulary_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+								},
+							},
+						},
+						"kinesis_data_stream_sink_configuration": insightsTargetSchema(),
+						"lambda_function_sink_configuration":     insightsTargetSchema(),
+						"s3_recording_sink_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"recording_file_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.RecordingFileFormat_Values(), false),
+									},
+								},
+							},
+						},
+						"sns_topic_sink_configuration": insightsTargetSchema(),
+						"sqs_queue_sink_configuration": insightsTargetSchema(),
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.MediaInsightsPipelineConfigurationElementType_Values(), false),
+						},
+						"voice_analytics_processor_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"speaker_search_status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.VoiceAnalyticsConfigurationStatus_Values(), false),
+									},
+									"voice_tone_analysis_status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.VoiceAnalyticsConfigurationStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 64),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z._-]+$`), "must contain only alphanumeric characters, periods, underscores and hyphens"),
+				),
+			},
+			"real_time_alert_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"issue_detection_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"rule_name": ruleNameSchema(),
+											},
+										},
+									},
+									"keyword_match_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"keywords": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 10,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringLenBetween(1, 100),
+													},
+												},
+												"negate": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"rule_name": ruleNameSchema(),
+											},
+										},
+									},
+									"sentiment_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"rule_name": ruleNameSchema(),
+												"sentiment_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.SentimentType_Values(), false),
+												},
+												"time_period": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(60, 1800),
+												},
+											},
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(chimesdkmediapipelines.RealTimeAlertRuleType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func insightsTargetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"insights_target": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func ruleNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(2, 64),
+			validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z._-]+$`), "must contain only alphanumeric characters, periods, underscores and hyphens"),
+		),
+	}
+}
+
+func resourceMediaInsightsPipelineConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+
+	name := d.Get("name").(string)
+	input := &chimesdkmediapipelines.CreateMediaInsightsPipelineConfigurationInput{
+		Elements:                               expandElements(d.Get("elements").([]interface{})),
+		MediaInsightsPipelineConfigurationName: aws.String(name),
+		ResourceAccessRoleArn:                  aws.String(d.Get("resource_access_role_arn").(string)),
+		Tags:                                   GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("real_time_alert_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RealTimeAlertConfiguration = expandRealTimeAlertConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	// IAM role propagation can delay the service's validation of the resource access role.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateMediaInsightsPipelineConfigurationWithContext(ctx, input)
+	}, chimesdkmediapipelines.ErrCodeBadRequestException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Chime SDK Media Insights Pipeline Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*chimesdkmediapipelines.CreateMediaInsightsPipelineConfigurationOutput).MediaInsightsPipelineConfiguration.MediaInsightsPipelineConfigurationId))
+
+	return append(diags, resourceMediaInsightsPipelineConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceMediaInsightsPipelineConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+
+	configuration, err := FindMediaInsightsPipelineConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Chime SDK Media Insights Pipeline Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Chime SDK Media Insights Pipeline Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", configuration.MediaInsightsPipelineConfigurationArn)
+	if err := d.Set("elements", flattenElements(configuration.Elements)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting elements: %s", err)
+	}
+	d.Set("name", configuration.MediaInsightsPipelineConfigurationName)
+	if configuration.RealTimeAlertConfiguration != nil {
+		if err := d.Set("real_time_alert_configuration", []interface{}{flattenRealTimeAlertConfiguration(configuration.RealTimeAlertConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting real_time_alert_configuration: %s", err)
+		}
+	} else {
+		d.Set("real_time_alert_configuration", nil)
+	}
+	d.Set("resource_access_role_arn", configuration.ResourceAccessRoleArn)
+
+	return diags
+}
+
+func resourceMediaInsightsPipelineConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &chimesdkmediapipelines.UpdateMediaInsightsPipelineConfigurationInput{
+			Elements:              expandElements(d.Get("elements").([]interface{})),
+			Identifier:            aws.String(d.Id()),
+			ResourceAccessRoleArn: aws.String(d.Get("resource_access_role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("real_time_alert_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RealTimeAlertConfiguration = expandRealTimeAlertConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateMediaInsightsPipelineConfigurationWithContext(ctx, input)
+		}, chimesdkmediapipelines.ErrCodeBadRequestException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Chime SDK Media Insights Pipeline Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMediaInsightsPipelineConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceMediaInsightsPipelineConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+
+	log.Printf("[INFO] Deleting Chime SDK Media Insights Pipeline Configuration: %s", d.Id())
+	_, err := conn.DeleteMediaInsightsPipelineConfigurationWithContext(ctx, &chimesdkmediapipelines.DeleteMediaInsightsPipelineConfigurationInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, chimesdkmediapipelines.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Chime SDK Media Insights Pipeline Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindMediaInsightsPipelineConfigurationByID(ctx context.Context, conn *chimesdkmediapipelines.ChimeSDKMediaPipelines, id string) (*chimesdkmediapipelines.MediaInsightsPipelineConfiguration, error) {
+	input := &chimesdkmediapipelines.GetMediaInsightsPipelineConfigurationInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetMediaInsightsPipelineConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, chimesdkmediapipelines.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MediaInsightsPipelineConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.MediaInsightsPipelineConfiguration, nil
+}
+
+func expandElements(tfList []interface{}) []*chimesdkmediapipelines.MediaInsightsPipelineConfigurationElement {
+	var apiObjects []*chimesdkmediapipelines.MediaInsightsPipelineConfigurationElement
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &chimesdkmediapipelines.MediaInsightsPipelineConfigurationElement{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["amazon_transcribe_call_analytics_processor_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AmazonTranscribeCallAnalyticsProcessorConfiguration = expandAmazonTranscribeCallAnalyticsProcessorConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["kinesis_data_stream_sink_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.KinesisDataStreamSinkConfiguration = &chimesdkmediapipelines.KinesisDataStreamSinkConfiguration{
+				InsightsTarget: aws.String(v[0].(map[string]interface{})["insights_target"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["lambda_function_sink_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LambdaFunctionSinkConfiguration = &chimesdkmediapipelines.LambdaFunctionSinkConfiguration{
+				InsightsTarget: aws.String(v[0].(map[string]interface{})["insights_target"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["s3_recording_sink_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3RecordingSinkConfiguration = expandS3RecordingSinkConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sns_topic_sink_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SnsTopicSinkConfiguration = &chimesdkmediapipelines.SnsTopicSinkConfiguration{
+				InsightsTarget: aws.String(v[0].(map[string]interface{})["insights_target"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sqs_queue_sink_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SqsQueueSinkConfiguration = &chimesdkmediapipelines.SqsQueueSinkConfiguration{
+				InsightsTarget: aws.String(v[0].(map[string]interface{})["insights_target"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["voice_analytics_processor_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.VoiceAnalyticsProcessorConfiguration = &chimesdkmediapipelines.VoiceAnalyticsProcessorConfiguration{
+				SpeakerSearchStatus:     aws.String(tfMap["speaker_search_status"].(string)),
+				VoiceToneAnalysisStatus: aws.String(tfMap["voice_tone_analysis_status"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAmazonTranscribeCallAnalyticsProcessorConfiguration(tfMap map[string]interface{}) *chimesdkmediapipelines.AmazonTranscribeCallAnalyticsProcessorConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &chimesdkmediapipelines.AmazonTranscribeCallAnalyticsProcessorConfiguration{
+		LanguageCode: aws.String(tfMap["language_code"].(string)),
+	}
+
+	if v, ok := tfMap["call_analytics_stream_categories"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CallAnalyticsStreamCategories = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["content_identification_type"].(string); ok && v != "" {
+		apiObject.ContentIdentificationType = aws.String(v)
+	}
+
+	if v, ok := tfMap["content_redaction_type"].(string); ok && v != "" {
+		apiObject.ContentRedactionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["enable_partial_results_stabilization"].(bool); ok && v {
+		apiObject.EnablePartialResultsStabilization = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["filter_partial_results"].(bool); ok && v {
+		apiObject.FilterPartialResults = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["language_model_name"].(string); ok && v != "" {
+		apiObject.LanguageModelName = aws.String(v)
+	}
+
+	if v, ok := tfMap["partial_results_stability"].(string); ok && v != "" {
+		apiObject.PartialResultsStability = aws.String(v)
+	}
+
+	if v, ok := tfMap["pii_entity_types"].(string); ok && v != "" {
+		apiObject.PiiEntityTypes = aws.String(v)
+	}
+
+	if v, ok := tfMap["post_call_analytics_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PostCallAnalyticsSettings = expandPostCallAnalyticsSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["vocabulary_filter_method"].(string); ok && v != "" {
+		apiObject.VocabularyFilterMethod = aws.String(v)
+	}
+
+	if v, ok := tfMap["vocabulary_filter_name"].(string); ok && v != "" {
+		apiObject.VocabularyFilterName = aws.String(v)
+	}
+
+	if v, ok := tfMap["vocabulary_name"].(string); ok && v != "" {
+		apiObject.VocabularyName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPostCallAnalyticsSettings(tfMap map[string]interface{}) *chimesdkmediapipelines.PostCallAnalyticsSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &chimesdkmediapipelines.PostCallAnalyticsSettings{
+		DataAccessRoleArn: aws.String(tfMap["data_access_role_arn"].(string)),
+		OutputLocation:    aws.String(tfMap["output_location"].(string)),
+	}
+
+	if v, ok := tfMap["content_redaction_output"].(string); ok && v != "" {
+		apiObject.ContentRedactionOutput = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_encryption_kms_key_id"].(string); ok && v != "" {
+		apiObject.OutputEncryptionKMSKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3RecordingSinkConfiguration(tfMap map[string]interface{}) *chimesdkmediapipelines.S3RecordingSinkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &chimesdkmediapipelines.S3RecordingSinkConfiguration{}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["recording_file_format"].(string); ok && v != "" {
+		apiObject.RecordingFileFormat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRealTimeAlertConfiguration(tfMap map[string]interface{}) *chimesdkmediapipelines.RealTimeAlertConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &chimesdkmediapipelines.RealTimeAlertConfiguration{
+		Disabled: aws.Bool(tfMap["disabled"].(bool)),
+	}
+
+	if v, ok := tfMap["rules"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandRealTimeAlertRules(v)
+	}
+
+	return apiObject
+}
+
+func expandRealTimeAlertRules(tfList []interface{}) []*chimesdkmediapipelines.RealTimeAlertRule {
+	var apiObjects []*chimesdkmediapipelines.RealTimeAlertRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &chimesdkmediapipelines.RealTimeAlertRule{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["issue_detection_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IssueDetectionConfiguration = &chimesdkmediapipelines.IssueDetectionConfiguration{
+				RuleName: aws.String(v[0].(map[string]interface{})["rule_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["keyword_match_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.KeywordMatchConfiguration = &chimesdkmediapipelines.KeywordMatchConfiguration{
+				Keywords: flex.ExpandStringList(tfMap["keywords"].([]interface{})),
+				Negate:   aws.Bool(tfMap["negate"].(bool)),
+				RuleName: aws.String(tfMap["rule_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sentiment_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SentimentConfiguration = &chimesdkmediapipelines.SentimentConfiguration{
+				RuleName:      aws.String(tfMap["rule_name"].(string)),
+				SentimentType: aws.String(tfMap["sentiment_type"].(string)),
+				TimePeriod:    aws.Int64(int64(tfMap["time_period"].(int))),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenElements(apiObjects []*chimesdkmediapipelines.MediaInsightsPipelineConfigurationElement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"type": aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.AmazonTranscribeCallAnalyticsProcessorConfiguration; v != nil {
+			tfMap["amazon_transcribe_call_analytics_processor_configuration"] = []interface{}{flattenAmazonTranscribeCallAnalyticsProcessorConfiguration(v)}
+		}
+
+		if v := apiObject.KinesisDataStreamSinkConfiguration; v != nil {
+			tfMap["kinesis_data_stream_sink_configuration"] = []interface{}{map[string]interface{}{
+				"insights_target": aws.StringValue(v.InsightsTarget),
+			}}
+		}
+
+		if v := apiObject.LambdaFunctionSinkConfiguration; v != nil {
+			tfMap["lambda_function_sink_configuration"] = []interface{}{map[string]interface{}{
+				"insights_target": aws.StringValue(v.InsightsTarget),
+			}}
+		}
+
+		if v := apiObject.S3RecordingSinkConfiguration; v != nil {
+			tfMap["s3_recording_sink_configuration"] = []interface{}{map[string]interface{}{
+				"destination":           aws.StringValue(v.Destination),
+				"recording_file_format": aws.StringValue(v.RecordingFileFormat),
+			}}
+		}
+
+		if v := apiObject.SnsTopicSinkConfiguration; v != nil {
+			tfMap["sns_topic_sink_configuration"] = []interface{}{map[string]interface{}{
+				"insights_target": aws.StringValue(v.InsightsTarget),
+			}}
+		}
+
+		if v := apiObject.SqsQueueSinkConfiguration; v != nil {
+			tfMap["sqs_queue_sink_configuration"] = []interface{}{map[string]interface{}{
+				"insights_target": aws.StringValue(v.InsightsTarget),
+			}}
+		}
+
+		if v := apiObject.VoiceAnalyticsProcessorConfiguration; v != nil {
+			tfMap["voice_analytics_processor_configuration"] = []interface{}{map[string]interface{}{
+				"speaker_search_status":      aws.StringValue(v.SpeakerSearchStatus),
+				"voice_tone_analysis_status": aws.StringValue(v.VoiceToneAnalysisStatus),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAmazonTranscribeCallAnalyticsProcessorConfiguration(apiObject *chimesdkmediapipelines.AmazonTranscribeCallAnalyticsProcessorConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"call_analytics_stream_categories":     aws.StringValueSlice(apiObject.CallAnalyticsStreamCategories),
+		"content_identification_type":          aws.StringValue(apiObject.ContentIdentificationType),
+		"content_redaction_type":               aws.StringValue(apiObject.ContentRedactionType),
+		"enable_partial_results_stabilization": aws.BoolValue(apiObject.EnablePartialResultsStabilization),
+		"filter_partial_results":               aws.BoolValue(apiObject.FilterPartialResults),
+		"language_code":                        aws.StringValue(apiObject.LanguageCode),
+		"language_model_name":                  aws.StringValue(apiObject.LanguageModelName),
+		"partial_results_stability":            aws.StringValue(apiObject.PartialResultsStability),
+		"pii_entity_types":                     aws.StringValue(apiObject.PiiEntityTypes),
+		"vocabulary_filter_method":             aws.StringValue(apiObject.VocabularyFilterMethod),
+		"vocabulary_filter_name":               aws.StringValue(apiObject.VocabularyFilterName),
+		"vocabulary_name":                      aws.StringValue(apiObject.VocabularyName),
+	}
+
+	if v := apiObject.PostCallAnalyticsSettings; v != nil {
+		tfMap["post_call_analytics_settings"] = []interface{}{map[string]interface{}{
+			"content_redaction_output":     aws.StringValue(v.ContentRedactionOutput),
+			"data_access_role_arn":         aws.StringValue(v.DataAccessRoleArn),
+			"output_encryption_kms_key_id": aws.StringValue(v.OutputEncryptionKMSKeyId),
+			"output_location":              aws.StringValue(v.OutputLocation),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenRealTimeAlertConfiguration(apiObject *chimesdkmediapipelines.RealTimeAlertConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"disabled": aws.BoolValue(apiObject.Disabled),
+	}
+
+	var rules []interface{}
+
+	for _, rule := range apiObject.Rules {
+		if rule == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"type": aws.StringValue(rule.Type),
+		}
+
+		if v := rule.IssueDetectionConfiguration; v != nil {
+			tfMap["issue_detection_configuration"] = []interface{}{map[string]interface{}{
+				"rule_name": aws.StringValue(v.RuleName),
+			}}
+		}
+
+		if v := rule.KeywordMatchConfiguration; v != nil {
+			tfMap["keyword_match_configuration"] = []interface{}{map[string]interface{}{
+				"keywords":  aws.StringValueSlice(v.Keywords),
+				"negate":    aws.BoolValue(v.Negate),
+				"rule_name": aws.StringValue(v.RuleName),
+			}}
+		}
+
+		if v := rule.SentimentConfiguration; v != nil {
+			tfMap["sentiment_configuration"] = []interface{}{map[string]interface{}{
+				"rule_name":      aws.StringValue(v.RuleName),
+				"sentiment_type": aws.StringValue(v.SentimentType),
+				"time_period":    aws.Int64Value(v.TimePeriod),
+			}}
+		}
+
+		rules = append(rules, tfMap)
+	}
+
+	tfMap["rules"] = rules
+
+	return tfMap
+}
@@ -0,0 +1,467 @@
+package chimesdkmediapipelines_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/chimesdkmediapipelines"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchimesdkmediapipelines "github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v chimesdkmediapipelines.MediaInsightsPipelineConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chimesdkmediapipelines.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaInsightsPipelineConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "chime", regexp.MustCompile(`media-insights-pipeline-configuration/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "elements.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "elements.0.type", "AmazonTranscribeCallAnalyticsProcessor"),
+					resource.TestCheckResourceAttr(resourceName, "elements.0.amazon_transcribe_call_analytics_processor_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "elements.0.amazon_transcribe_call_analytics_processor_configuration.0.language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "elements.1.type", "KinesisDataStreamSink"),
+					resource.TestCheckResourceAttrPair(resourceName, "elements.1.kinesis_data_stream_sink_configuration.0.insights_target", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v chimesdkmediapipelines.MediaInsightsPipelineConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chimesdkmediapipelines.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaInsightsPipelineConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfchimesdkmediapipelines.ResourceMediaInsightsPipelineConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v chimesdkmediapipelines.MediaInsightsPipelineConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chimesdkmediapipelines.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaInsightsPipelineConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "elements.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.#", "0"),
+				),
+			},
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "elements.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "elements.0.amazon_transcribe_call_analytics_processor_configuration.0.post_call_analytics_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "elements.2.type", "S3RecordingSink"),
+					resource.TestCheckResourceAttrPair(resourceName, "elements.2.s3_recording_sink_configuration.0.destination", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "elements.3.type", "VoiceAnalyticsProcessor"),
+					resource.TestCheckResourceAttr(resourceName, "elements.3.voice_analytics_processor_configuration.0.speaker_search_status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "elements.3.voice_analytics_processor_configuration.0.voice_tone_analysis_status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.0.type", "IssueDetection"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.1.type", "KeywordMatch"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.1.keyword_match_configuration.0.keywords.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.2.type", "Sentiment"),
+					resource.TestCheckResourceAttr(resourceName, "real_time_alert_configuration.0.rules.2.sentiment_configuration.0.time_period", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v chimesdkmediapipelines.MediaInsightsPipelineConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chimesdkmediapipelines.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaInsightsPipelineConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMediaInsightsPipelineConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaInsightsPipelineConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMediaInsightsPipelineConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" {
+				continue
+			}
+
+			_, err := tfchimesdkmediapipelines.FindMediaInsightsPipelineConfigurationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chime SDK Media Insights Pipeline Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMediaInsightsPipelineConfigurationExists(ctx context.Context, n string, v *chimesdkmediapipelines.MediaInsightsPipelineConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Chime SDK Media Insights Pipeline Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+
+		output, err := tfchimesdkmediapipelines.FindMediaInsightsPipelineConfigurationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+
+	input := &chimesdkmediapipelines.ListMediaInsightsPipelineConfigurationsInput{}
+	_, err := conn.ListMediaInsightsPipelineConfigurationsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccMediaInsightsPipelineConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "mediapipelines.chime.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "transcribe:StartCallAnalyticsStreamTranscription",
+        "kinesis:PutRecord",
+        "s3:PutObject",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccMediaInsightsPipelineConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMediaInsightsPipelineConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" "test" {
+  name                     = %[1]q
+  resource_access_role_arn = aws_iam_role.test.arn
+
+  elements {
+    type = "AmazonTranscribeCallAnalyticsProcessor"
+
+    amazon_transcribe_call_analytics_processor_configuration {
+      language_code = "en-US"
+    }
+  }
+
+  elements {
+    type = "KinesisDataStreamSink"
+
+    kinesis_data_stream_sink_configuration {
+      insights_target = aws_kinesis_stream.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMediaInsightsPipelineConfigurationConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccMediaInsightsPipelineConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" "test" {
+  name                     = %[1]q
+  resource_access_role_arn = aws_iam_role.test.arn
+
+  elements {
+    type = "AmazonTranscribeCallAnalyticsProcessor"
+
+    amazon_transcribe_call_analytics_processor_configuration {
+      call_analytics_stream_categories = ["category_1", "category_2"]
+      content_redaction_type           = "PII"
+      language_code                    = "en-US"
+      partial_results_stability        = "high"
+      pii_entity_types                 = "ADDRESS,BANK_ACCOUNT_NUMBER"
+
+      post_call_analytics_settings {
+        content_redaction_output = "redacted"
+        data_access_role_arn     = aws_iam_role.test.arn
+        output_location          = "s3://${aws_s3_bucket.test.id}/post-call"
+      }
+    }
+  }
+
+  elements {
+    type = "KinesisDataStreamSink"
+
+    kinesis_data_stream_sink_configuration {
+      insights_target = aws_kinesis_stream.test.arn
+    }
+  }
+
+  elements {
+    type = "S3RecordingSink"
+
+    s3_recording_sink_configuration {
+      destination           = aws_s3_bucket.test.arn
+      recording_file_format = "Wav"
+    }
+  }
+
+  elements {
+    type = "VoiceAnalyticsProcessor"
+
+    voice_analytics_processor_configuration {
+      speaker_search_status      = "Enabled"
+      voice_tone_analysis_status = "Enabled"
+    }
+  }
+
+  real_time_alert_configuration {
+    disabled = false
+
+    rules {
+      type = "IssueDetection"
+
+      issue_detection_configuration {
+        rule_name = "IssueDetectionRule"
+      }
+    }
+
+    rules {
+      type = "KeywordMatch"
+
+      keyword_match_configuration {
+        keywords  = ["hello", "thank you"]
+        negate    = false
+        rule_name = "KeywordMatchRule"
+      }
+    }
+
+    rules {
+      type = "Sentiment"
+
+      sentiment_configuration {
+        rule_name      = "SentimentRule"
+        sentiment_type = "NEGATIVE"
+        time_period    = 60
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMediaInsightsPipelineConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMediaInsightsPipelineConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" "test" {
+  name                     = %[1]q
+  resource_access_role_arn = aws_iam_role.test.arn
+
+  elements {
+    type = "AmazonTranscribeCallAnalyticsProcessor"
+
+    amazon_transcribe_call_analytics_processor_configuration {
+      language_code = "en-US"
+    }
+  }
+
+  elements {
+    type = "KinesisDataStreamSink"
+
+    kinesis_data_stream_sink_configuration {
+      insights_target = aws_kinesis_stream.test.arn
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMediaInsightsPipelineConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMediaInsightsPipelineConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" "test" {
+  name                     = %[1]q
+  resource_access_role_arn = aws_iam_role.test.arn
+
+  elements {
+    type = "AmazonTranscribeCallAnalyticsProcessor"
+
+    amazon_transcribe_call_analytics_processor_configuration {
+      language_code = "en-US"
+    }
+  }
+
+  elements {
+    type = "KinesisDataStreamSink"
+
+    kinesis_data_stream_sink_configuration {
+      insights_target = aws_kinesis_stream.test.arn
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package chimesdkmediapipelines
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_chimesdkmediapipelines_media_insights_pipeline_configuration", &resource.Sweeper{
+		Name: "aws_chimesdkmediapipelines_media_insights_pipeline_configuration",
+		F:    sweepMediaInsightsPipelineConfigurations,
+	})
+}
+
+func sweepMediaInsightsPipelineConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ChimeSDKMediaPipelinesConn()
+	input := &chimesdkmediapipelines.ListMediaInsightsPipelineConfigurationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListMediaInsightsPipelineConfigurationsPagesWithContext(ctx, input, func(page *chimesdkmediapipelines.ListMediaInsightsPipelineConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MediaInsightsPipelineConfigurations {
+			r := ResourceMediaInsightsPipelineConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MediaInsightsPipelineConfigurationId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Chime SDK Media Insights Pipeline Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Chime SDK Media Insights Pipeline Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Chime SDK Media Insights Pipeline Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set_instance",
 		F:    sweepStackSetInstances,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -29,7 +29,7 @@ func init() {
 		F: sweepStackSets,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
 		Name: "aws_cloudformation_stack",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -181,32 +181,36 @@ func sweepStacks(region string) error {
 		for _, stack := range page.StackSummaries {
 			name := aws.StringValue(stack.StackName)
 
-			updateTerminationProtectionInput := &cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   stack.StackName,
-			}
+			log.Printf("[INFO] Deleting CloudFormation Stack: %s", name)
+			err := sweep.DeleteFunc(ctx, client, "aws_cloudformation_stack", name, func() error {
+				updateTerminationProtectionInput := &cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(false),
+					StackName:                   stack.StackName,
+				}
 
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtectionWithContext(ctx, updateTerminationProtectionInput)
+				log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
+				_, err := conn.UpdateTerminationProtectionWithContext(ctx, updateTerminationProtectionInput)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error disabling termination protection for CloudFormation Stack (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err != nil {
+					return fmt.Errorf("error disabling termination protection for CloudFormation Stack (%s): %w", name, err)
+				}
 
-			input := &cloudformation.DeleteStackInput{
-				StackName: stack.StackName,
-			}
+				input := &cloudformation.DeleteStackInput{
+					StackName: stack.StackName,
+				}
 
-			log.Printf("[INFO] Deleting CloudFormation Stack: %s", name)
-			_, err = conn.DeleteStackWithContext(ctx, input)
+				_, err = conn.DeleteStackWithContext(ctx, input)
+
+				if err != nil {
+					return fmt.Errorf("error deleting CloudFormation Stack (%s): %w", name, err)
+				}
+
+				return nil
+			})
 
 			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudFormation Stack (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
 			}
 		}
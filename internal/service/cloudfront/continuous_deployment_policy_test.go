@@ -0,0 +1,435 @@
+package cloudfront_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontContinuousDeploymentPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	var stagingDistribution, productionDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_singleWeight(0.01),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					testAccCheckDistributionExists(ctx, stagingDistributionResourceName, &stagingDistribution),
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_distribution_dns_names.0.quantity", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "staging_distribution_dns_names.0.items.*", stagingDistributionResourceName, "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleWeight"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.01"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.idle_ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.session_stickiness_config.0.maximum_ttl", "600"),
+					resource.TestCheckResourceAttr(stagingDistributionResourceName, "staging", "true"),
+					resource.TestCheckResourceAttr(productionDistributionResourceName, "staging", "false"),
+					resource.TestCheckResourceAttrPair(productionDistributionResourceName, "continuous_deployment_policy_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_singleWeight(0.15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.0.weight", "0.15"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_policyOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudfront.ResourceContinuousDeploymentPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_singleHeader(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_singleHeader("aws-cf-cd-test", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.type", "SingleHeader"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.header", "aws-cf-cd-test"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.value", "test"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_weight_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_singleHeader("aws-cf-cd-test2", "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.header", "aws-cf-cd-test2"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_config.0.single_header_config.0.value", "test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontContinuousDeploymentPolicy_promote(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy cloudfront.GetContinuousDeploymentPolicyOutput
+	var productionDistribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_continuous_deployment_policy.test"
+	stagingDistributionResourceName := "aws_cloudfront_distribution.staging"
+	productionDistributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContinuousDeploymentPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_promote("test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContinuousDeploymentPolicyExists(ctx, resourceName, &policy),
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(productionDistributionResourceName, "comment", "test"),
+					resource.TestCheckResourceAttrPair(productionDistributionResourceName, "staging_distribution_etag", stagingDistributionResourceName, "etag"),
+				),
+			},
+			{
+				Config: testAccContinuousDeploymentPolicyConfig_promote("promoted"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, productionDistributionResourceName, &productionDistribution),
+					resource.TestCheckResourceAttr(stagingDistributionResourceName, "comment", "promoted"),
+					resource.TestCheckResourceAttr(productionDistributionResourceName, "comment", "promoted"),
+					resource.TestCheckResourceAttr(productionDistributionResourceName, "staging", "false"),
+					resource.TestCheckResourceAttrPair(productionDistributionResourceName, "continuous_deployment_policy_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(productionDistributionResourceName, "staging_distribution_etag", stagingDistributionResourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContinuousDeploymentPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudfront_continuous_deployment_policy" {
+				continue
+			}
+
+			_, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CloudFront, create.ErrActionCheckingDestroyed, tfcloudfront.ResNameContinuousDeploymentPolicy, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContinuousDeploymentPolicyExists(ctx context.Context, name string, v *cloudfront.GetContinuousDeploymentPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameContinuousDeploymentPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameContinuousDeploymentPolicy, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
+
+		output, err := tfcloudfront.FindContinuousDeploymentPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.CloudFront, create.ErrActionCheckingExistence, tfcloudfront.ResNameContinuousDeploymentPolicy, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccContinuousDeploymentPolicyConfig_baseStagingDistribution(comment string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "staging" {
+  comment          = %[1]q
+  enabled          = true
+  retain_on_delete = false
+  staging          = true
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, comment)
+}
+
+func testAccContinuousDeploymentPolicyConfig_baseProductionDistribution(comment string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  comment                         = %[1]q
+  enabled                         = true
+  retain_on_delete                = false
+  continuous_deployment_policy_id = aws_cloudfront_continuous_deployment_policy.test.id
+  staging_distribution_etag       = aws_cloudfront_distribution.staging.etag
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`, comment)
+}
+
+func testAccContinuousDeploymentPolicyConfig_policyOnly() string {
+	return acctest.ConfigCompose(testAccContinuousDeploymentPolicyConfig_baseStagingDistribution("test"), `
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = false
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = 0.01
+    }
+  }
+}
+`)
+}
+
+func testAccContinuousDeploymentPolicyConfig_singleWeight(weight float64) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_baseStagingDistribution("test"),
+		testAccContinuousDeploymentPolicyConfig_baseProductionDistribution("test"),
+		fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = %[1]g
+
+      session_stickiness_config {
+        idle_ttl    = 300
+        maximum_ttl = 600
+      }
+    }
+  }
+}
+`, weight))
+}
+
+func testAccContinuousDeploymentPolicyConfig_singleHeader(header, value string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_baseStagingDistribution("test"),
+		testAccContinuousDeploymentPolicyConfig_baseProductionDistribution("test"),
+		fmt.Sprintf(`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleHeader"
+
+    single_header_config {
+      header = %[1]q
+      value  = %[2]q
+    }
+  }
+}
+`, header, value))
+}
+
+func testAccContinuousDeploymentPolicyConfig_promote(comment string) string {
+	return acctest.ConfigCompose(
+		testAccContinuousDeploymentPolicyConfig_baseStagingDistribution(comment),
+		testAccContinuousDeploymentPolicyConfig_baseProductionDistribution(comment),
+		`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items    = [aws_cloudfront_distribution.staging.domain_name]
+    quantity = 1
+  }
+
+  traffic_config {
+    type = "SingleWeight"
+
+    single_weight_config {
+      weight = 0.01
+    }
+  }
+}
+`)
+}
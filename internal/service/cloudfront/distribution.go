@@ -2,6 +2,7 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -231,6 +232,10 @@ func ResourceDistribution() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -675,6 +680,16 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"staging_distribution_etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"viewer_certificate": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -932,6 +947,25 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 		IfMatch:            aws.String(d.Get("etag").(string)),
 	}
 
+	// When the staging distribution's ETag changes on a primary distribution with an attached continuous deployment policy,
+	// promote the staging distribution's configuration. The promoted configuration replaces the primary distribution's own.
+	if v, ok := d.GetOk("continuous_deployment_policy_id"); ok && !d.HasChange("continuous_deployment_policy_id") && d.HasChange("staging_distribution_etag") {
+		if stagingETag := d.Get("staging_distribution_etag").(string); stagingETag != "" {
+			if err := promoteStagingDistribution(ctx, conn, d.Id(), v.(string), d.Get("etag").(string), stagingETag); err != nil {
+				return sdkdiag.AppendErrorf(diags, "promoting CloudFront Distribution (%s) staging configuration: %s", d.Id(), err)
+			}
+
+			if d.Get("wait_for_deployment").(bool) {
+				log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
+				if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+				}
+			}
+
+			return append(diags, resourceDistributionRead(ctx, d, meta)...)
+		}
+	}
+
 	// Handle eventual consistency issues
 	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
 		_, err := conn.UpdateDistributionWithContext(ctx, params)
@@ -1030,6 +1064,24 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 		IfMatch: aws.String(d.Get("etag").(string)),
 	}
 
+	// A primary distribution cannot be deleted while a continuous deployment policy is attached to it.
+	// The policy itself is left to the aws_cloudfront_continuous_deployment_policy resource.
+	if _, ok := d.GetOk("continuous_deployment_policy_id"); ok {
+		etag, err := detachContinuousDeploymentPolicy(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detaching CloudFront Continuous Deployment Policy from CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+
+		if etag != "" {
+			if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+			}
+
+			deleteDistroInput.IfMatch = aws.String(etag)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting CloudFront Distribution: %s", d.Id())
 	_, err := conn.DeleteDistributionWithContext(ctx, deleteDistroInput)
 
@@ -1130,6 +1182,108 @@ func FindDistributionByID(ctx context.Context, conn *cloudfront.CloudFront, id s
 	return output, nil
 }
 
+// findStagingDistributionIDByDomainName returns the ID of the staging distribution with the specified domain name.
+func findStagingDistributionIDByDomainName(ctx context.Context, conn *cloudfront.CloudFront, domainName string) (string, error) {
+	input := &cloudfront.ListDistributionsInput{}
+	var id string
+
+	err := conn.ListDistributionsPagesWithContext(ctx, input, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+		if page == nil || page.DistributionList == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DistributionList.Items {
+			if v != nil && aws.BoolValue(v.Staging) && aws.StringValue(v.DomainName) == domainName {
+				id = aws.StringValue(v.Id)
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if id == "" {
+		return "", &retry.NotFoundError{
+			Message:     fmt.Sprintf("no staging distribution with domain name %s", domainName),
+			LastRequest: input,
+		}
+	}
+
+	return id, nil
+}
+
+// promoteStagingDistribution copies the configuration of the staging distribution referenced by
+// the specified continuous deployment policy to the primary distribution.
+// etag and stagingETag are the current ETags of the primary and staging distributions.
+func promoteStagingDistribution(ctx context.Context, conn *cloudfront.CloudFront, id, policyID, etag, stagingETag string) error {
+	policy, err := FindContinuousDeploymentPolicyByID(ctx, conn, policyID)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudFront Continuous Deployment Policy (%s): %w", policyID, err)
+	}
+
+	config := policy.ContinuousDeploymentPolicy.ContinuousDeploymentPolicyConfig
+
+	if config == nil || config.StagingDistributionDnsNames == nil || len(config.StagingDistributionDnsNames.Items) == 0 {
+		return fmt.Errorf("CloudFront Continuous Deployment Policy (%s) has no staging distribution", policyID)
+	}
+
+	stagingID, err := findStagingDistributionIDByDomainName(ctx, conn, aws.StringValue(config.StagingDistributionDnsNames.Items[0]))
+
+	if err != nil {
+		return err
+	}
+
+	input := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(id),
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", etag, stagingETag)),
+		StagingDistributionId: aws.String(stagingID),
+	}
+
+	_, err = conn.UpdateDistributionWithStagingConfigWithContext(ctx, input)
+
+	return err
+}
+
+// detachContinuousDeploymentPolicy removes any continuous deployment policy from the specified distribution.
+// The distribution's new ETag is returned, or an empty string if no policy was attached.
+func detachContinuousDeploymentPolicy(ctx context.Context, conn *cloudfront.CloudFront, id string) (string, error) {
+	output, err := FindDistributionByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("reading CloudFront Distribution (%s): %w", id, err)
+	}
+
+	config := output.Distribution.DistributionConfig
+
+	if aws.StringValue(config.ContinuousDeploymentPolicyId) == "" {
+		return "", nil
+	}
+
+	config.ContinuousDeploymentPolicyId = aws.String("")
+
+	updateOutput, err := conn.UpdateDistributionWithContext(ctx, &cloudfront.UpdateDistributionInput{
+		DistributionConfig: config,
+		Id:                 aws.String(id),
+		IfMatch:            output.ETag,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(updateOutput.ETag), nil
+}
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
@@ -26,6 +26,23 @@ func ListCachePoliciesPages(ctx context.Context, conn *cloudfront.CloudFront, in
 	return nil
 }
 
+func ListContinuousDeploymentPoliciesPages(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListContinuousDeploymentPoliciesInput, fn func(*cloudfront.ListContinuousDeploymentPoliciesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListContinuousDeploymentPoliciesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.ContinuousDeploymentPolicyList.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.ContinuousDeploymentPolicyList.NextMarker
+	}
+	return nil
+}
+
 func ListFieldLevelEncryptionConfigsPages(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListFieldLevelEncryptionConfigsInput, fn func(*cloudfront.ListFieldLevelEncryptionConfigsOutput, bool) bool) error {
 	for {
 		output, err := conn.ListFieldLevelEncryptionConfigsWithContext(ctx, input)
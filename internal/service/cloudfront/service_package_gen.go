@@ -66,6 +66,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCachePolicy,
 			TypeName: "aws_cloudfront_cache_policy",
 		},
+		{
+			Factory:  ResourceContinuousDeploymentPolicy,
+			TypeName: "aws_cloudfront_continuous_deployment_policy",
+		},
 		{
 			Factory:  ResourceDistribution,
 			TypeName: "aws_cloudfront_distribution",
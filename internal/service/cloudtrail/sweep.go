@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
 		Name: "aws_cloudtrail",
 		F:    sweeps,
 	})
@@ -66,8 +66,12 @@ func sweeps(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting CloudTrail: %s", name)
-			_, err = conn.DeleteTrailWithContext(ctx, &cloudtrail.DeleteTrailInput{
-				Name: aws.String(name),
+			err = sweep.DeleteFunc(ctx, client, "aws_cloudtrail", name, func() error {
+				_, err := conn.DeleteTrailWithContext(ctx, &cloudtrail.DeleteTrailInput{
+					Name: aws.String(name),
+				})
+
+				return err
 			})
 			if tfawserr.ErrCodeEquals(err, cloudtrail.ErrCodeTrailNotFoundException) {
 				continue
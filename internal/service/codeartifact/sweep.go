@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
 		Name: "aws_codeartifact_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
 		Name: "aws_codeartifact_repository",
 		F:    sweepRepositories,
 	})
@@ -50,7 +50,11 @@ func sweepDomains(region string) error {
 
 			log.Printf("[INFO] Deleting CodeArtifact Domain: %s", domain)
 
-			_, err := conn.DeleteDomainWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_codeartifact_domain", domain, func() error {
+				_, err := conn.DeleteDomainWithContext(ctx, input)
+
+				return err
+			})
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting CodeArtifact Domain (%s): %w", domain, err)
@@ -99,7 +103,11 @@ func sweepRepositories(region string) error {
 
 			log.Printf("[INFO] Deleting CodeArtifact Repository: %s", repository)
 
-			_, err := conn.DeleteRepositoryWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_codeartifact_repository", repository, func() error {
+				_, err := conn.DeleteRepositoryWithContext(ctx, input)
+
+				return err
+			})
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting CodeArtifact Repository (%s): %w", repository, err)
@@ -15,12 +15,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
 		Name: "aws_cognito_user_pool_domain",
 		F:    sweepUserPoolDomains,
 	})
 
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
 		Name: "aws_cognito_user_pool",
 		F:    sweepUserPools,
 		Dependencies: []string{
@@ -59,9 +59,13 @@ func sweepUserPoolDomains(region string) error {
 				domain := aws.StringValue(output.UserPool.Domain)
 
 				log.Printf("[INFO] Deleting Cognito user pool domain: %s", domain)
-				_, err := conn.DeleteUserPoolDomainWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolDomainInput{
-					Domain:     output.UserPool.Domain,
-					UserPoolId: u.Id,
+				err := sweep.DeleteFunc(ctx, client, "aws_cognito_user_pool_domain", domain, func() error {
+					_, err := conn.DeleteUserPoolDomainWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolDomainInput{
+						Domain:     output.UserPool.Domain,
+						UserPoolId: u.Id,
+					})
+
+					return err
 				})
 				if err != nil {
 					log.Printf("[ERROR] Failed deleting Cognito user pool domain (%s): %s", domain, err)
@@ -104,8 +108,12 @@ func sweepUserPools(region string) error {
 			name := aws.StringValue(userPool.Name)
 
 			log.Printf("[INFO] Deleting Cognito User Pool: %s", name)
-			_, err := conn.DeleteUserPoolWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolInput{
-				UserPoolId: userPool.Id,
+			err := sweep.DeleteFunc(ctx, client, "aws_cognito_user_pool", aws.StringValue(userPool.Id), func() error {
+				_, err := conn.DeleteUserPoolWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolInput{
+					UserPoolId: userPool.Id,
+				})
+
+				return err
 			})
 			if err != nil {
 				log.Printf("[ERROR] Failed deleting Cognito User Pool (%s): %s", name, err)
@@ -0,0 +1,43 @@
+package computeoptimizer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccComputeOptimizer_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"EnrollmentStatus": {
+			"basic":                 testAccEnrollmentStatus_basic,
+			"includeMemberAccounts": testAccEnrollmentStatus_includeMemberAccounts,
+		},
+		"RecommendationPreferences": {
+			"basic":      testAccRecommendationPreferences_basic,
+			"disappears": testAccRecommendationPreferences_disappears,
+			"update":     testAccRecommendationPreferences_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient()
+
+	input := &computeoptimizer.GetEnrollmentStatusInput{}
+	_, err := conn.GetEnrollmentStatus(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
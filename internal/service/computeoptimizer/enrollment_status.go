@@ -0,0 +1,169 @@
+package computeoptimizer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_computeoptimizer_enrollment_status", name="Enrollment Status")
+func ResourceEnrollmentStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnrollmentStatusPut,
+		ReadWithoutTimeout:   resourceEnrollmentStatusRead,
+		UpdateWithoutTimeout: resourceEnrollmentStatusPut,
+		DeleteWithoutTimeout: resourceEnrollmentStatusDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"include_member_accounts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"number_of_member_accounts_opted_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(types.StatusActive, types.StatusInactive), false),
+			},
+		},
+	}
+}
+
+func resourceEnrollmentStatusPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient()
+
+	status := types.Status(d.Get("status").(string))
+	input := &computeoptimizer.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: d.Get("include_member_accounts").(bool),
+		Status:                status,
+	}
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Compute Optimizer Enrollment Status: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitEnrollmentStatusUpdated(ctx, conn, status, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Compute Optimizer Enrollment Status (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEnrollmentStatusRead(ctx, d, meta)...)
+}
+
+func resourceEnrollmentStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient()
+
+	output, err := FindEnrollmentStatus(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Compute Optimizer Enrollment Status (%s): %s", d.Id(), err)
+	}
+
+	d.Set("include_member_accounts", output.MemberAccountsEnrolled)
+	d.Set("number_of_member_accounts_opted_in", output.NumberOfMemberAccountsOptedIn)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceEnrollmentStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient()
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, &computeoptimizer.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: d.Get("include_member_accounts").(bool),
+		Status:                types.StatusInactive,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Compute Optimizer Enrollment Status (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEnrollmentStatusUpdated(ctx, conn, types.StatusInactive, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Compute Optimizer Enrollment Status (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	input := &computeoptimizer.GetEnrollmentStatusInput{}
+
+	output, err := conn.GetEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnrollmentStatus(ctx, conn)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEnrollmentStatusUpdated(ctx context.Context, conn *computeoptimizer.Client, status types.Status, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.StatusPending),
+		Target:  enum.Slice(status),
+		Refresh: statusEnrollmentStatus(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*computeoptimizer.GetEnrollmentStatusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,104 @@
+package computeoptimizer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnrollmentStatus_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic("Active"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_member_accounts_opted_in", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_basic("Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnrollmentStatus_includeMemberAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "number_of_member_accounts_opted_in"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnrollmentStatusConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = %[1]q
+}
+`, status)
+}
+
+func testAccEnrollmentStatusConfig_includeMemberAccounts(includeMemberAccounts bool) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  include_member_accounts = %[1]t
+  status                  = "Active"
+}
+`, includeMemberAccounts)
+}
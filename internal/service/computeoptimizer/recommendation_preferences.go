@@ -0,0 +1,295 @@
+package computeoptimizer
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_computeoptimizer_recommendation_preferences", name="Recommendation Preferences")
+func ResourceRecommendationPreferences() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecommendationPreferencesPut,
+		ReadWithoutTimeout:   resourceRecommendationPreferencesRead,
+		UpdateWithoutTimeout: resourceRecommendationPreferencesPut,
+		DeleteWithoutTimeout: resourceRecommendationPreferencesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enhanced_infrastructure_metrics": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.EnhancedInfrastructureMetrics](),
+			},
+			"external_metrics_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.ExternalMetricsSource](),
+						},
+					},
+				},
+			},
+			"inferred_workload_types": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.InferredWorkloadTypesPreference](),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(types.ResourceTypeAutoScalingGroup, types.ResourceTypeEc2Instance), false),
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.ScopeName](),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const recommendationPreferencesResourceIDPartCount = 3
+
+func resourceRecommendationPreferencesPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient()
+
+	resourceType := d.Get("resource_type").(string)
+	scope := expandScope(d.Get("scope").([]interface{})[0].(map[string]interface{}))
+	id, err := flex.FlattenResourceId([]string{resourceType, string(scope.Name), aws.ToString(scope.Value)}, recommendationPreferencesResourceIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &computeoptimizer.PutRecommendationPreferencesInput{
+		ResourceType: types.ResourceType(resourceType),
+		Scope:        scope,
+	}
+
+	if v, ok := d.GetOk("enhanced_infrastructure_metrics"); ok {
+		input.EnhancedInfrastructureMetrics = types.EnhancedInfrastructureMetrics(v.(string))
+	}
+
+	if v, ok := d.GetOk("external_metrics_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExternalMetricsPreference = &types.ExternalMetricsPreference{
+			Source: types.ExternalMetricsSource(v.([]interface{})[0].(map[string]interface{})["source"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("inferred_workload_types"); ok {
+		input.InferredWorkloadTypes = types.InferredWorkloadTypesPreference(v.(string))
+	}
+
+	_, err = conn.PutRecommendationPreferences(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Compute Optimizer Recommendation Preferences (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	} else if d.HasChanges("enhanced_infrastructure_metrics", "external_metrics_preference", "inferred_workload_types") {
+		// Preferences that have been removed from configuration must be explicitly deleted.
+		var preferenceNames []types.RecommendationPreferenceName
+
+		if _, ok := d.GetOk("enhanced_infrastructure_metrics"); !ok {
+			preferenceNames = append(preferenceNames, types.RecommendationPreferenceNameEnhancedInfrastructureMetrics)
+		}
+
+		if _, ok := d.GetOk("external_metrics_preference"); !ok {
+			preferenceNames = append(preferenceNames, types.RecommendationPreferenceNameExternalMetricsPreference)
+		}
+
+		if _, ok := d.GetOk("inferred_workload_types"); !ok {
+			preferenceNames = append(preferenceNames, types.RecommendationPreferenceNameInferredWorkloadTypes)
+		}
+
+		if len(preferenceNames) > 0 {
+			_, err := conn.DeleteRecommendationPreferences(ctx, &computeoptimizer.DeleteRecommendationPreferencesInput{
+				RecommendationPreferenceNames: preferenceNames,
+				ResourceType:                  types.ResourceType(resourceType),
+				Scope:                         scope,
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Compute Optimizer Recommendation Preferences (%s): %s", id, err)
+			}
+		}
+	}
+
+	return append(diags, resourceRecommendationPreferencesRead(ctx, d, meta)...)
+}
+
+func resourceRecommendationPreferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient()
+
+	parts, err := flex.ExpandResourceId(d.Id(), recommendationPreferencesResourceIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	resourceType, scopeName, scopeValue := parts[0], parts[1], parts[2]
+	preferences, err := FindRecommendationPreferencesByThreePartKey(ctx, conn, resourceType, scopeName, scopeValue)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Compute Optimizer Recommendation Preferences (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Compute Optimizer Recommendation Preferences (%s): %s", d.Id(), err)
+	}
+
+	d.Set("enhanced_infrastructure_metrics", preferences.EnhancedInfrastructureMetrics)
+	if preferences.ExternalMetricsPreference != nil {
+		if err := d.Set("external_metrics_preference", []interface{}{map[string]interface{}{
+			"source": string(preferences.ExternalMetricsPreference.Source),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting external_metrics_preference: %s", err)
+		}
+	} else {
+		d.Set("external_metrics_preference", nil)
+	}
+	d.Set("inferred_workload_types", preferences.InferredWorkloadTypes)
+	d.Set("resource_type", preferences.ResourceType)
+	if err := d.Set("scope", []interface{}{flattenScope(preferences.Scope)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scope: %s", err)
+	}
+
+	return diags
+}
+
+func resourceRecommendationPreferencesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComputeOptimizerClient()
+
+	log.Printf("[INFO] Deleting Compute Optimizer Recommendation Preferences: %s", d.Id())
+	_, err := conn.DeleteRecommendationPreferences(ctx, &computeoptimizer.DeleteRecommendationPreferencesInput{
+		RecommendationPreferenceNames: []types.RecommendationPreferenceName{
+			types.RecommendationPreferenceNameEnhancedInfrastructureMetrics,
+			types.RecommendationPreferenceNameExternalMetricsPreference,
+			types.RecommendationPreferenceNameInferredWorkloadTypes,
+		},
+		ResourceType: types.ResourceType(d.Get("resource_type").(string)),
+		Scope:        expandScope(d.Get("scope").([]interface{})[0].(map[string]interface{})),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Compute Optimizer Recommendation Preferences (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRecommendationPreferencesByThreePartKey(ctx context.Context, conn *computeoptimizer.Client, resourceType, scopeName, scopeValue string) (*types.RecommendationPreferencesDetail, error) {
+	input := &computeoptimizer.GetRecommendationPreferencesInput{
+		ResourceType: types.ResourceType(resourceType),
+		Scope: &types.Scope{
+			Name:  types.ScopeName(scopeName),
+			Value: aws.String(scopeValue),
+		},
+	}
+	var output []types.RecommendationPreferencesDetail
+
+	pages := computeoptimizer.NewGetRecommendationPreferencesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RecommendationPreferencesDetails {
+			// Preferences set at a broader scope are also returned.
+			if v.Scope != nil && string(v.Scope.Name) == scopeName && aws.ToString(v.Scope.Value) == scopeValue {
+				output = append(output, v)
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output[0], nil
+}
+
+func expandScope(tfMap map[string]interface{}) *types.Scope {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &types.Scope{
+		Name:  types.ScopeName(tfMap["name"].(string)),
+		Value: aws.String(tfMap["value"].(string)),
+	}
+}
+
+func flattenScope(apiObject *types.Scope) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"name":  string(apiObject.Name),
+		"value": aws.ToString(apiObject.Value),
+	}
+}
@@ -0,0 +1,222 @@
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "Ec2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", "AccountId"),
+					acctest.CheckResourceAttrAccountID(resourceName, "scope.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcomputeoptimizer.ResourceRecommendationPreferences(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", ""),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_updated(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", ""),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_metrics_preference.0.source", "Datadog"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Compute Optimizer Recommendation Preferences ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient()
+
+		_, err = tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		return err
+	}
+}
+
+func testAccCheckRecommendationPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_recommendation_preferences" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Compute Optimizer Recommendation Preferences %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccRecommendationPreferencesConfig_base = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = "Active"
+}
+`
+
+func testAccRecommendationPreferencesConfig_basic() string {
+	return acctest.ConfigCompose(testAccRecommendationPreferencesConfig_base, `
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  enhanced_infrastructure_metrics = "Active"
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test]
+}
+`)
+}
+
+func testAccRecommendationPreferencesConfig_updated() string {
+	return acctest.ConfigCompose(testAccRecommendationPreferencesConfig_base, `
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  external_metrics_preference {
+    source = "Datadog"
+  }
+
+  inferred_workload_types = "Active"
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test]
+}
+`)
+}
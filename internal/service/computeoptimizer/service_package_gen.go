@@ -24,7 +24,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceEnrollmentStatus,
+			TypeName: "aws_computeoptimizer_enrollment_status",
+			Name:     "Enrollment Status",
+		},
+		{
+			Factory:  ResourceRecommendationPreferences,
+			TypeName: "aws_computeoptimizer_recommendation_preferences",
+			Name:     "Recommendation Preferences",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -18,22 +18,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
 		Name: "aws_config_delivery_channel",
 		Dependencies: []string{
 			"aws_config_configuration_recorder",
@@ -68,9 +68,13 @@ func sweepAggregateAuthorizations(region string) error {
 
 	for _, auth := range aggregateAuthorizations {
 		log.Printf("[INFO] Deleting config authorization %s", *auth.AggregationAuthorizationArn)
-		_, err := conn.DeleteAggregationAuthorizationWithContext(ctx, &configservice.DeleteAggregationAuthorizationInput{
-			AuthorizedAccountId: auth.AuthorizedAccountId,
-			AuthorizedAwsRegion: auth.AuthorizedAwsRegion,
+		err := sweep.DeleteFunc(ctx, client, "aws_config_aggregate_authorization", aws.StringValue(auth.AggregationAuthorizationArn), func() error {
+			_, err := conn.DeleteAggregationAuthorizationWithContext(ctx, &configservice.DeleteAggregationAuthorizationInput{
+				AuthorizedAccountId: auth.AuthorizedAccountId,
+				AuthorizedAwsRegion: auth.AuthorizedAwsRegion,
+			})
+
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf("Error deleting config aggregate authorization %s: %s", *auth.AggregationAuthorizationArn, err)
@@ -106,8 +110,12 @@ func sweepConfigurationAggregators(region string) error {
 
 	for _, agg := range resp.ConfigurationAggregators {
 		log.Printf("[INFO] Deleting config configuration aggregator %s", *agg.ConfigurationAggregatorName)
-		_, err := conn.DeleteConfigurationAggregatorWithContext(ctx, &configservice.DeleteConfigurationAggregatorInput{
-			ConfigurationAggregatorName: agg.ConfigurationAggregatorName,
+		err := sweep.DeleteFunc(ctx, client, "aws_config_configuration_aggregator", aws.StringValue(agg.ConfigurationAggregatorName), func() error {
+			_, err := conn.DeleteConfigurationAggregatorWithContext(ctx, &configservice.DeleteConfigurationAggregatorInput{
+				ConfigurationAggregatorName: agg.ConfigurationAggregatorName,
+			})
+
+			return err
 		})
 
 		if err != nil {
@@ -143,15 +151,19 @@ func sweepConfigurationRecorder(region string) error {
 	}
 
 	for _, cr := range resp.ConfigurationRecorders {
-		_, err := conn.StopConfigurationRecorderWithContext(ctx, &configservice.StopConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return err
-		}
+		err := sweep.DeleteFunc(ctx, client, "aws_config_configuration_recorder", aws.StringValue(cr.Name), func() error {
+			_, err := conn.StopConfigurationRecorderWithContext(ctx, &configservice.StopConfigurationRecorderInput{
+				ConfigurationRecorderName: cr.Name,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = conn.DeleteConfigurationRecorderWithContext(ctx, &configservice.DeleteConfigurationRecorderInput{
+				ConfigurationRecorderName: cr.Name,
+			})
 
-		_, err = conn.DeleteConfigurationRecorderWithContext(ctx, &configservice.DeleteConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf(
@@ -199,8 +211,12 @@ func sweepDeliveryChannels(region string) error {
 	}
 
 	for _, dc := range resp.DeliveryChannels {
-		_, err := conn.DeleteDeliveryChannelWithContext(ctx, &configservice.DeleteDeliveryChannelInput{
-			DeliveryChannelName: dc.Name,
+		err := sweep.DeleteFunc(ctx, client, "aws_config_delivery_channel", aws.StringValue(dc.Name), func() error {
+			_, err := conn.DeleteDeliveryChannelWithContext(ctx, &configservice.DeleteDeliveryChannelInput{
+				DeliveryChannelName: dc.Name,
+			})
+
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf(
@@ -18,52 +18,52 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
 		Name: "aws_datasync_location_efs",
 		F:    sweepLocationEFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_datasync_location_fsx_windows_file_system",
 		F:    sweepLocationFSxWindows,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_lustre_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_lustre_file_system", &resource.Sweeper{
 		Name: "aws_datasync_location_fsx_lustre_file_system",
 		F:    sweepLocationFSxLustres,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
 		Name: "aws_datasync_location_nfs",
 		F:    sweepLocationNFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
 		Name: "aws_datasync_location_s3",
 		F:    sweepLocationS3s,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
 		Name: "aws_datasync_location_smb",
 		F:    sweepLocationSMBs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_hdfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_hdfs", &resource.Sweeper{
 		Name: "aws_datasync_location_hdfs",
 		F:    sweepLocationHDFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_object_storage", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_object_storage", &resource.Sweeper{
 		Name: "aws_datasync_location_object_storage",
 		F:    sweepLocationObjectStorages,
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
@@ -103,7 +103,11 @@ func sweepAgents(region string) error {
 				AgentArn: agent.AgentArn,
 			}
 
-			_, err := conn.DeleteAgentWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_datasync_agent", aws.StringValue(agent.AgentArn), func() error {
+				_, err := conn.DeleteAgentWithContext(ctx, input)
+
+				return err
+			})
 
 			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "does not exist") {
 				continue
@@ -161,7 +165,11 @@ func sweepLocationEFSs(region string) error {
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocationWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_datasync_location_efs", aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocationWithContext(ctx, input)
+
+				return err
+			})
 
 			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
 				continue
@@ -219,7 +227,11 @@ func sweepLocationFSxWindows(region string) error {
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocationWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_datasync_location_fsx_windows_file_system", aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocationWithContext(ctx, input)
+
+				return err
+			})
 
 			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
 				continue
@@ -392,7 +404,11 @@ func sweepLocationS3s(region string) error {
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocationWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_datasync_location_s3", aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocationWithContext(ctx, input)
+
+				return err
+			})
 
 			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
 				continue
@@ -566,7 +582,11 @@ func sweepLocationObjectStorages(region string) error {
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocationWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_datasync_location_object_storage", aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocationWithContext(ctx, input)
+
+				return err
+			})
 
 			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
 				continue
@@ -621,7 +641,11 @@ func sweepTasks(region string) error {
 				TaskArn: task.TaskArn,
 			}
 
-			_, err := conn.DeleteTaskWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_datasync_task", aws.StringValue(task.TaskArn), func() error {
+				_, err := conn.DeleteTaskWithContext(ctx, input)
+
+				return err
+			})
 
 			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
 				continue
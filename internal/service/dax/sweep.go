@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
 		Name: "aws_dax_cluster",
 		F:    sweepClusters,
 	})
@@ -49,8 +50,12 @@ func sweepClusters(region string) error {
 
 	for _, cluster := range resp.Clusters {
 		log.Printf("[INFO] Deleting DAX cluster %s", *cluster.ClusterName)
-		_, err := conn.DeleteClusterWithContext(ctx, &dax.DeleteClusterInput{
-			ClusterName: cluster.ClusterName,
+		err := sweep.DeleteFunc(ctx, client, "aws_dax_cluster", aws.StringValue(cluster.ClusterName), func() error {
+			_, err := conn.DeleteClusterWithContext(ctx, &dax.DeleteClusterInput{
+				ClusterName: cluster.ClusterName,
+			})
+
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf("Error deleting DAX cluster %s: %s", *cluster.ClusterName, err)
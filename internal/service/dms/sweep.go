@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -24,12 +24,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
 
-	resource.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
 		Name: "aws_dms_endpoint",
 		F:    sweepEndpoints,
 	})
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
 		Name: "aws_docdb_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
 		Name: "aws_docdb_subnet_group",
 		F:    sweepDBSubnetGroups,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
 		Name: "aws_docdb_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
 		Name: "aws_docdb_cluster",
 		F:    sweepDBClusters,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_docdb_cluster_snapshot",
 		F:    sweepDBClusterSnapshots,
 		Dependencies: []string{
@@ -55,12 +55,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
 		Name: "aws_docdb_cluster_instance",
 		F:    sweepDBInstances,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_docdb_cluster_parameter_group",
 		F:    sweepDBClusterParameterGroups,
 		Dependencies: []string{
@@ -90,15 +90,22 @@ func sweepDBClusters(region string) error {
 
 			log.Printf("[INFO] Deleting DocDB Cluster: %s", id)
 
-			_, err := conn.DeleteDBClusterWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_docdb_cluster", id, func() error {
+				_, err := conn.DeleteDBClusterWithContext(ctx, input)
+
+				if err != nil {
+					return err
+				}
+
+				if err := WaitForDBClusterDeletion(ctx, conn, id, DBClusterDeleteTimeout); err != nil {
+					return fmt.Errorf("waiting for deletion: %w", err)
+				}
+
+				return nil
+			})
 
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete DocDB Cluster (%s): %s", id, err)
-				continue
-			}
-
-			if err := WaitForDBClusterDeletion(ctx, conn, id, DBClusterDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Cluster (%s) to be deleted: %s", id, err)
 			}
 		}
 		return !lastPage
@@ -136,15 +143,22 @@ func sweepDBClusterSnapshots(region string) error {
 
 			log.Printf("[INFO] Deleting DocDB Cluster Snapshot: %s", name)
 
-			_, err := conn.DeleteDBClusterSnapshotWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_docdb_cluster_snapshot", name, func() error {
+				_, err := conn.DeleteDBClusterSnapshotWithContext(ctx, input)
+
+				if err != nil {
+					return err
+				}
+
+				if err := WaitForDBClusterSnapshotDeletion(ctx, conn, name, DBClusterSnapshotDeleteTimeout); err != nil {
+					return fmt.Errorf("waiting for deletion: %w", err)
+				}
+
+				return nil
+			})
 
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete DocDB Cluster Snapshot (%s): %s", name, err)
-				continue
-			}
-
-			if err := WaitForDBClusterSnapshotDeletion(ctx, conn, name, DBClusterSnapshotDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Cluster Snapshot (%s) to be deleted: %s", name, err)
 			}
 		}
 		return !lastPage
@@ -187,7 +201,11 @@ func sweepDBClusterParameterGroups(region string) error {
 
 			log.Printf("[INFO] Deleting DocDB Cluster Parameter Group: %s", name)
 
-			_, err := conn.DeleteDBClusterParameterGroupWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_docdb_cluster_parameter_group", name, func() error {
+				_, err := conn.DeleteDBClusterParameterGroupWithContext(ctx, input)
+
+				return err
+			})
 
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete DocDB Parameter Group (%s): %s", name, err)
@@ -274,15 +292,22 @@ func sweepGlobalClusters(region string) error {
 
 			log.Printf("[INFO] Deleting DocDB Global Cluster: %s", id)
 
-			_, err := conn.DeleteGlobalClusterWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_docdb_global_cluster", id, func() error {
+				_, err := conn.DeleteGlobalClusterWithContext(ctx, input)
+
+				if err != nil {
+					return err
+				}
+
+				if err := WaitForGlobalClusterDeletion(ctx, conn, id, GlobalClusterDeleteTimeout); err != nil {
+					return fmt.Errorf("waiting for deletion: %w", err)
+				}
+
+				return nil
+			})
 
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete DocDB Global Cluster (%s): %s", id, err)
-				continue
-			}
-
-			if err := WaitForGlobalClusterDeletion(ctx, conn, id, GlobalClusterDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Global Cluster (%s) to be deleted: %s", id, err)
 			}
 		}
 		return !lastPage
@@ -320,15 +345,22 @@ func sweepDBSubnetGroups(region string) error {
 
 			log.Printf("[INFO] Deleting DocDB Subnet Group: %s", name)
 
-			_, err := conn.DeleteDBSubnetGroupWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_docdb_subnet_group", name, func() error {
+				_, err := conn.DeleteDBSubnetGroupWithContext(ctx, input)
+
+				if err != nil {
+					return err
+				}
+
+				if err := WaitForDBSubnetGroupDeletion(ctx, conn, name, DBSubnetGroupDeleteTimeout); err != nil {
+					return fmt.Errorf("waiting for deletion: %w", err)
+				}
+
+				return nil
+			})
 
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete DocDB Subnet Group (%s): %s", name, err)
-				continue
-			}
-
-			if err := WaitForDBSubnetGroupDeletion(ctx, conn, name, DBSubnetGroupDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Subnet Group (%s) to be deleted: %s", name, err)
 			}
 		}
 		return !lastPage
@@ -366,15 +398,22 @@ func sweepEventSubscriptions(region string) error {
 
 			log.Printf("[INFO] Deleting DocDB Event Subscription: %s", id)
 
-			_, err := conn.DeleteEventSubscriptionWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_docdb_event_subscription", id, func() error {
+				_, err := conn.DeleteEventSubscriptionWithContext(ctx, input)
+
+				if err != nil {
+					return err
+				}
+
+				if _, err := waitEventSubscriptionDeleted(ctx, conn, id, EventSubscriptionDeleteTimeout); err != nil {
+					return fmt.Errorf("waiting for deletion: %w", err)
+				}
+
+				return nil
+			})
 
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete DocDB Event Subscription (%s): %s", id, err)
-				continue
-			}
-
-			if _, err := waitEventSubscriptionDeleted(ctx, conn, id, EventSubscriptionDeleteTimeout); err != nil {
-				log.Printf("[ERROR] Failure while waiting for DocDB Event Subscription (%s) to be deleted: %s", id, err)
 			}
 		}
 		return !lastPage
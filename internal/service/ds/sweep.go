@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_directory_service_region", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_region", &resource.Sweeper{
 		Name: "aws_directory_service_region",
 		F:    sweepRegions,
 	})
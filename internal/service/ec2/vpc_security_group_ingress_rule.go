@@ -338,8 +338,6 @@ func (r *resourceSecurityGroupRule) ModifyPlan(ctx context.Context, request reso
 			response.RequiresReplace = []path.Path{path.Root(old), path.Root(new)}
 		}
 	}
-
-	r.SetTagsAll(ctx, request, response)
 }
 
 func (r *resourceSecurityGroupRule) ConfigValidators(_ context.Context) []resource.ConfigValidator {
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
 		Name: "aws_ecr_repository",
 		F:    sweepRepositories,
 	})
@@ -41,11 +41,15 @@ func sweepRepositories(region string) error {
 			repositoryName := aws.StringValue(repository.RepositoryName)
 			log.Printf("[INFO] Deleting ECR repository: %s", repositoryName)
 
-			_, err = conn.DeleteRepositoryWithContext(ctx, &ecr.DeleteRepositoryInput{
-				// We should probably sweep repositories even if there are images.
-				Force:          aws.Bool(true),
-				RegistryId:     repository.RegistryId,
-				RepositoryName: repository.RepositoryName,
+			err = sweep.DeleteFunc(ctx, client, "aws_ecr_repository", repositoryName, func() error {
+				_, err := conn.DeleteRepositoryWithContext(ctx, &ecr.DeleteRepositoryInput{
+					// We should probably sweep repositories even if there are images.
+					Force:          aws.Bool(true),
+					RegistryId:     repository.RegistryId,
+					RepositoryName: repository.RepositoryName,
+				})
+
+				return err
 			})
 			if err != nil {
 				if !tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
 		Name: "aws_ecs_capacity_provider",
 		F:    sweepCapacityProviders,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
 		Name: "aws_ecs_task_definition",
 		F:    sweepTaskDefinitions,
 		Dependencies: []string{
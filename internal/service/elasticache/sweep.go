@@ -25,7 +25,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -33,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -47,7 +47,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
 		Name: "aws_elasticache_security_group",
 		F:    sweepCacheSecurityGroups,
 		Dependencies: []string{
@@ -64,7 +64,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -97,15 +97,20 @@ func sweepClusters(region string) error {
 			id := aws.StringValue(cluster.CacheClusterId)
 
 			log.Printf("[INFO] Deleting ElastiCache Cluster: %s", id)
-			err := DeleteCacheCluster(ctx, conn, id, "")
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Cache Cluster (%s): %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", id, err))
-			}
-			_, err = WaitCacheClusterDeleted(ctx, conn, id, CacheClusterDeletedTimeout)
+			err := sweep.DeleteFunc(ctx, client, "aws_elasticache_cluster", id, func() error {
+				if err := DeleteCacheCluster(ctx, conn, id, ""); err != nil {
+					return fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", id, err)
+				}
+
+				if _, err := WaitCacheClusterDeleted(ctx, conn, id, CacheClusterDeletedTimeout); err != nil {
+					return fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): waiting for completion: %w", id, err)
+				}
+
+				return nil
+			})
 			if err != nil {
-				log.Printf("[ERROR] Failed waiting for ElastiCache Cache Cluster (%s) to be deleted: %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): waiting for completion: %w", id, err))
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
 			}
 		}
 		return !lastPage
@@ -145,17 +150,19 @@ func sweepGlobalReplicationGroups(region string) error {
 			grgGroup.Go(func() error {
 				id := aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId)
 
-				disassociationErrors := DisassociateMembers(ctx, conn, globalReplicationGroup)
-				if disassociationErrors != nil {
-					return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, disassociationErrors)
-				}
-
-				log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
-				err := deleteGlobalReplicationGroup(ctx, conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
-				if err != nil {
-					return fmt.Errorf("deleting ElastiCache Global Replication Group (%s): %w", id, err)
-				}
-				return nil
+				return sweep.DeleteFunc(ctx, client, "aws_elasticache_global_replication_group", id, func() error {
+					disassociationErrors := DisassociateMembers(ctx, conn, globalReplicationGroup)
+					if disassociationErrors != nil {
+						return fmt.Errorf("disassociating ElastiCache Global Replication Group (%s) members: %w", id, disassociationErrors)
+					}
+
+					log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
+					err := deleteGlobalReplicationGroup(ctx, conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout, globalReplicationGroupDefaultDeletedTimeout)
+					if err != nil {
+						return fmt.Errorf("deleting ElastiCache Global Replication Group (%s): %w", id, err)
+					}
+					return nil
+				})
 			})
 		}
 
@@ -199,8 +206,12 @@ func sweepParameterGroups(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting ElastiCache Parameter Group: %s", name)
-			_, err := conn.DeleteCacheParameterGroupWithContext(ctx, &elasticache.DeleteCacheParameterGroupInput{
-				CacheParameterGroupName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_elasticache_parameter_group", name, func() error {
+				_, err := conn.DeleteCacheParameterGroupWithContext(ctx, &elasticache.DeleteCacheParameterGroupInput{
+					CacheParameterGroupName: aws.String(name),
+				})
+
+				return err
 			})
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete ElastiCache Parameter Group (%s): %s", name, err)
@@ -293,8 +304,12 @@ func sweepCacheSecurityGroups(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting ElastiCache Cache Security Group: %s", name)
-			_, err := conn.DeleteCacheSecurityGroupWithContext(ctx, &elasticache.DeleteCacheSecurityGroupInput{
-				CacheSecurityGroupName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_elasticache_security_group", name, func() error {
+				_, err := conn.DeleteCacheSecurityGroupWithContext(ctx, &elasticache.DeleteCacheSecurityGroupInput{
+					CacheSecurityGroupName: aws.String(name),
+				})
+
+				return err
 			})
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete ElastiCache Cache Security Group (%s): %s", name, err)
@@ -335,8 +350,12 @@ func sweepSubnetGroups(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting ElastiCache Subnet Group: %s", name)
-			_, err := conn.DeleteCacheSubnetGroupWithContext(ctx, &elasticache.DeleteCacheSubnetGroupInput{
-				CacheSubnetGroupName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_elasticache_subnet_group", name, func() error {
+				_, err := conn.DeleteCacheSubnetGroupWithContext(ctx, &elasticache.DeleteCacheSubnetGroupInput{
+					CacheSubnetGroupName: aws.String(name),
+				})
+
+				return err
 			})
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete ElastiCache Subnet Group (%s): %s", name, err)
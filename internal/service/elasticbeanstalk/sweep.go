@@ -17,13 +17,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
 		Name:         "aws_elastic_beanstalk_application",
 		Dependencies: []string{"aws_elastic_beanstalk_environment"},
 		F:            sweepApplications,
 	})
 
-	resource.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
 		Name: "aws_elastic_beanstalk_environment",
 		F:    sweepEnvironments,
 	})
@@ -54,8 +54,12 @@ func sweepApplications(region string) error {
 	var errors error
 	for _, bsa := range resp.Applications {
 		applicationName := aws.StringValue(bsa.ApplicationName)
-		_, err := conn.DeleteApplicationWithContext(ctx, &elasticbeanstalk.DeleteApplicationInput{
-			ApplicationName: bsa.ApplicationName,
+		err := sweep.DeleteFunc(ctx, client, "aws_elastic_beanstalk_application", applicationName, func() error {
+			_, err := conn.DeleteApplicationWithContext(ctx, &elasticbeanstalk.DeleteApplicationInput{
+				ApplicationName: bsa.ApplicationName,
+			})
+
+			return err
 		})
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, "InvalidConfiguration.NotFound") || tfawserr.ErrCodeEquals(err, "ValidationError") {
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elb", &resource.Sweeper{
 		Name: "aws_elb",
 		F:    sweepLoadBalancers,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb", &resource.Sweeper{
 		Name: "aws_lb",
 		F:    sweepLoadBalancers,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    sweepTargetGroups,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
@@ -59,8 +59,12 @@ func sweepLoadBalancers(region string) error {
 			name := aws.StringValue(loadBalancer.LoadBalancerName)
 
 			log.Printf("[INFO] Deleting LB: %s", name)
-			_, err := conn.DeleteLoadBalancerWithContext(ctx, &elbv2.DeleteLoadBalancerInput{
-				LoadBalancerArn: loadBalancer.LoadBalancerArn,
+			err := sweep.DeleteFunc(ctx, client, "aws_lb", aws.StringValue(loadBalancer.LoadBalancerArn), func() error {
+				_, err := conn.DeleteLoadBalancerWithContext(ctx, &elbv2.DeleteLoadBalancerInput{
+					LoadBalancerArn: loadBalancer.LoadBalancerArn,
+				})
+
+				return err
 			})
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("failed to delete LB (%s): %w", name, err))
@@ -98,8 +102,12 @@ func sweepTargetGroups(region string) error {
 			name := aws.StringValue(targetGroup.TargetGroupName)
 
 			log.Printf("[INFO] Deleting LB Target Group: %s", name)
-			_, err := conn.DeleteTargetGroupWithContext(ctx, &elbv2.DeleteTargetGroupInput{
-				TargetGroupArn: targetGroup.TargetGroupArn,
+			err := sweep.DeleteFunc(ctx, client, "aws_lb_target_group", aws.StringValue(targetGroup.TargetGroupArn), func() error {
+				_, err := conn.DeleteTargetGroupWithContext(ctx, &elbv2.DeleteTargetGroupInput{
+					TargetGroupArn: targetGroup.TargetGroupArn,
+				})
+
+				return err
 			})
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete LB Target Group (%s): %s", name, err)
@@ -12,16 +12,17 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
@@ -37,7 +38,7 @@ func sweepClusters(region string) error {
 	input := &emr.ListClustersInput{
 		ClusterStates: aws.StringSlice([]string{emr.ClusterStateBootstrapping, emr.ClusterStateRunning, emr.ClusterStateStarting, emr.ClusterStateWaiting}),
 	}
-	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
 
 	err = conn.ListClustersPagesWithContext(ctx, input, func(page *emr.ListClustersOutput, lastPage bool) bool {
 		if page == nil {
@@ -46,21 +47,26 @@ func sweepClusters(region string) error {
 
 		for _, v := range page.Clusters {
 			id := aws.StringValue(v.Id)
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(id)
 
-			_, err := conn.SetTerminationProtectionWithContext(ctx, &emr.SetTerminationProtectionInput{
-				JobFlowIds:           aws.StringSlice([]string{id}),
-				TerminationProtected: aws.Bool(false),
+			err := sweep.DeleteFunc(ctx, client, "aws_emr_cluster", id, func() error {
+				_, err := conn.SetTerminationProtectionWithContext(ctx, &emr.SetTerminationProtectionInput{
+					JobFlowIds:           aws.StringSlice([]string{id}),
+					TerminationProtected: aws.Bool(false),
+				})
+
+				if err != nil {
+					log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				}
+
+				return sdkdiag.DiagnosticsError(r.DeleteWithoutTimeout(ctx, d, client))
 			})
 
 			if err != nil {
-				log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping EMR Cluster (%s): %w", id, err))
 			}
-
-			r := ResourceCluster()
-			d := r.Data(nil)
-			d.SetId(id)
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
@@ -68,20 +74,14 @@ func sweepClusters(region string) error {
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping EMR Clusters sweep for %s: %s", region, err)
-		return nil
+		return sweeperErrs.ErrorOrNil()
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing EMR Clusters (%s): %w", region, err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EMR Clusters (%s): %w", region, err))
 	}
 
-	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EMR Clusters (%s): %w", region, err)
-	}
-
-	return nil
+	return sweeperErrs.ErrorOrNil()
 }
 
 func sweepStudios(region string) error {
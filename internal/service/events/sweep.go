@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_api_destination",
 		F:    sweepAPIDestination,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_archive",
 		F:    sweepArchives,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
@@ -43,17 +43,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_connection",
 		F:    sweepConnection,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    sweepPermissions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -61,7 +61,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    sweepTargets,
 	})
@@ -106,7 +106,11 @@ func sweepAPIDestination(region string) error {
 		input := &eventbridge.DeleteApiDestinationInput{
 			Name: apiDestination.Name,
 		}
-		_, err := conn.DeleteApiDestinationWithContext(ctx, input)
+		err := sweep.DeleteFunc(ctx, client, "aws_cloudwatch_event_api_destination", aws.StringValue(apiDestination.Name), func() error {
+			_, err := conn.DeleteApiDestinationWithContext(ctx, input)
+
+			return err
+		})
 		if err != nil {
 			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge Api Destination (%s): %w", *apiDestination.Name, err))
 			continue
@@ -152,8 +156,12 @@ func sweepArchives(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting EventBridge archive (%s)", name)
-			_, err := conn.DeleteArchiveWithContext(ctx, &eventbridge.DeleteArchiveInput{
-				ArchiveName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_cloudwatch_event_archive", name, func() error {
+				_, err := conn.DeleteArchiveWithContext(ctx, &eventbridge.DeleteArchiveInput{
+					ArchiveName: aws.String(name),
+				})
+
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("Error deleting EventBridge archive (%s): %w", name, err)
@@ -252,7 +260,11 @@ func sweepConnection(region string) error {
 		input := &eventbridge.DeleteConnectionInput{
 			Name: connection.Name,
 		}
-		_, err := conn.DeleteConnectionWithContext(ctx, input)
+		err := sweep.DeleteFunc(ctx, client, "aws_cloudwatch_event_connection", aws.StringValue(connection.Name), func() error {
+			_, err := conn.DeleteConnectionWithContext(ctx, input)
+
+			return err
+		})
 		if err != nil {
 			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge Connection (%s): %w", *connection.Name, err))
 			continue
@@ -298,8 +310,12 @@ func sweepPermissions(region string) error {
 		sid := statement.Sid
 
 		log.Printf("[INFO] Deleting EventBridge Permission %s", sid)
-		_, err := conn.RemovePermissionWithContext(ctx, &eventbridge.RemovePermissionInput{
-			StatementId: aws.String(sid),
+		err := sweep.DeleteFunc(ctx, client, "aws_cloudwatch_event_permission", sid, func() error {
+			_, err := conn.RemovePermissionWithContext(ctx, &eventbridge.RemovePermissionInput{
+				StatementId: aws.String(sid),
+			})
+
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf("Error deleting EventBridge Permission %s: %w", sid, err)
@@ -340,10 +356,14 @@ func sweepRules(region string) error {
 					ruleName := aws.StringValue(rule.Name)
 
 					log.Printf("[DEBUG] Deleting EventBridge Rule: %s/%s", eventBusName, ruleName)
-					_, err := conn.DeleteRuleWithContext(ctx, &eventbridge.DeleteRuleInput{
-						EventBusName: aws.String(eventBusName),
-						Force:        aws.Bool(true),
-						Name:         aws.String(ruleName),
+					err := sweep.DeleteFunc(ctx, client, "aws_cloudwatch_event_rule", RuleCreateResourceID(eventBusName, ruleName), func() error {
+						_, err := conn.DeleteRuleWithContext(ctx, &eventbridge.DeleteRuleInput{
+							EventBusName: aws.String(eventBusName),
+							Force:        aws.Bool(true),
+							Name:         aws.String(ruleName),
+						})
+
+						return err
 					})
 
 					if err != nil {
@@ -423,11 +443,15 @@ func sweepTargets(region string) error {
 							targetID := aws.StringValue(target.Id)
 
 							log.Printf("[DEBUG] Deleting EventBridge Target: %s/%s/%s", eventBusName, ruleName, targetID)
-							_, err := conn.RemoveTargetsWithContext(ctx, &eventbridge.RemoveTargetsInput{
-								EventBusName: aws.String(eventBusName),
-								Force:        aws.Bool(true),
-								Ids:          aws.StringSlice([]string{targetID}),
-								Rule:         aws.String(ruleName),
+							err := sweep.DeleteFunc(ctx, client, "aws_cloudwatch_event_target", TargetCreateResourceID(eventBusName, ruleName, targetID), func() error {
+								_, err := conn.RemoveTargetsWithContext(ctx, &eventbridge.RemoveTargetsInput{
+									EventBusName: aws.String(eventBusName),
+									Force:        aws.Bool(true),
+									Ids:          aws.StringSlice([]string{targetID}),
+									Rule:         aws.String(ruleName),
+								})
+
+								return err
 							})
 
 							if err != nil {
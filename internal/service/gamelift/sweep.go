@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -25,17 +25,17 @@ func init() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
 		Name: "aws_gamelift_script",
 		F:    sweepScripts,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -43,12 +43,12 @@ func init() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
 		Name: "aws_gamelift_game_server_group",
 		F:    sweepGameServerGroups,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
@@ -72,8 +72,12 @@ func sweepAliases(region string) error {
 
 		for _, alias := range resp.Aliases {
 			log.Printf("[INFO] Deleting GameLift Alias %q", *alias.AliasId)
-			_, err := conn.DeleteAliasWithContext(ctx, &gamelift.DeleteAliasInput{
-				AliasId: alias.AliasId,
+			err := sweep.DeleteFunc(ctx, client, "aws_gamelift_alias", aws.StringValue(alias.AliasId), func() error {
+				_, err := conn.DeleteAliasWithContext(ctx, &gamelift.DeleteAliasInput{
+					AliasId: alias.AliasId,
+				})
+
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("Error deleting GameLift Alias (%s): %s",
@@ -119,8 +123,12 @@ func sweepBuilds(region string) error {
 
 	for _, build := range resp.Builds {
 		log.Printf("[INFO] Deleting GameLift Build %q", *build.BuildId)
-		_, err := conn.DeleteBuildWithContext(ctx, &gamelift.DeleteBuildInput{
-			BuildId: build.BuildId,
+		err := sweep.DeleteFunc(ctx, client, "aws_gamelift_build", aws.StringValue(build.BuildId), func() error {
+			_, err := conn.DeleteBuildWithContext(ctx, &gamelift.DeleteBuildInput{
+				BuildId: build.BuildId,
+			})
+
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf("Error deleting GameLift Build (%s): %s",
@@ -157,8 +165,12 @@ func sweepScripts(region string) error {
 
 	for _, build := range resp.Scripts {
 		log.Printf("[INFO] Deleting GameLift Script %q", *build.ScriptId)
-		_, err := conn.DeleteScriptWithContext(ctx, &gamelift.DeleteScriptInput{
-			ScriptId: build.ScriptId,
+		err := sweep.DeleteFunc(ctx, client, "aws_gamelift_script", aws.StringValue(build.ScriptId), func() error {
+			_, err := conn.DeleteScriptWithContext(ctx, &gamelift.DeleteScriptInput{
+				ScriptId: build.ScriptId,
+			})
+
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf("Error deleting GameLift Script (%s): %s",
@@ -307,8 +319,12 @@ func sweepGameSessionQueue(region string) error {
 
 	for _, queue := range out.GameSessionQueues {
 		log.Printf("[INFO] Deleting GameLift Session Queue %q", *queue.Name)
-		_, err := conn.DeleteGameSessionQueueWithContext(ctx, &gamelift.DeleteGameSessionQueueInput{
-			Name: aws.String(*queue.Name),
+		err := sweep.DeleteFunc(ctx, client, "aws_gamelift_game_session_queue", aws.StringValue(queue.Name), func() error {
+			_, err := conn.DeleteGameSessionQueueWithContext(ctx, &gamelift.DeleteGameSessionQueueInput{
+				Name: aws.String(*queue.Name),
+			})
+
+			return err
 		})
 		if err != nil {
 			return fmt.Errorf("deleting GameLift Session Queue (%s): %s",
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
 		Name: "aws_globalaccelerator_listener",
 		F:    sweepListeners,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
 		Name: "aws_globalaccelerator_endpoint_group",
 		F:    sweepEndpointGroups,
 	})
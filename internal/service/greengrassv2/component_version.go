@@ -0,0 +1,713 @@
+package greengrassv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_component_version", name="Component Version")
+// @Tags(identifierAttribute="arn")
+func ResourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentVersionCreate,
+		ReadWithoutTimeout:   resourceComponentVersionRead,
+		UpdateWithoutTimeout: resourceComponentVersionUpdate,
+		DeleteWithoutTimeout: resourceComponentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
+				ExactlyOneOf:     []string{"inline_recipe", "lambda_function"},
+			},
+			"lambda_function": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"inline_recipe", "lambda_function"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_dependency": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"component_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"dependency_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.ComponentDependencyType_Values(), false),
+									},
+									"version_requirement": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_lambda_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"environment_variables": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"event_source": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"topic": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.LambdaEventSourceType_Values(), false),
+												},
+											},
+										},
+									},
+									"exec_args": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"input_payload_encoding_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.LambdaInputPayloadEncodingType_Values(), false),
+									},
+									"linux_process_params": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"container_params": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"device": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"add_group_owner": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																		"permission": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: validation.StringInSlice(greengrassv2.LambdaFilesystemPermission_Values(), false),
+																		},
+																	},
+																},
+															},
+															"memory_size_in_kb": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																ForceNew: true,
+															},
+															"mount_ro_sysfs": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"volume": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"add_group_owner": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"destination_path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																		"permission": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: validation.StringInSlice(greengrassv2.LambdaFilesystemPermission_Values(), false),
+																		},
+																		"source_path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"isolation_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.LambdaIsolationMode_Values(), false),
+												},
+											},
+										},
+									},
+									"max_idle_time_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_instances_count": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_queue_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"pinned": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"status_timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"component_platform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"lambda_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceComponentVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	input := &greengrassv2.CreateComponentVersionInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Tags:        GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("inline_recipe"); ok {
+		input.InlineRecipe = []byte(v.(string))
+	}
+
+	if v, ok := d.GetOk("lambda_function"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LambdaFunction = expandLambdaFunctionRecipeSource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateComponentVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Component Version: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waitComponentVersionDeployable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Greengrass V2 Component Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	output, err := FindComponentVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", output.ComponentVersion)
+	d.Set("description", output.Description)
+	d.Set("publisher", output.Publisher)
+	if output.Status != nil {
+		d.Set("status", output.Status.ComponentState)
+	} else {
+		d.Set("status", nil)
+	}
+
+	// The source Lambda function configuration isn't returned, so the recipe is only read for inline recipes.
+	if _, ok := d.GetOk("lambda_function"); !ok {
+		format := greengrassv2.RecipeOutputFormatJson
+		if v, ok := d.GetOk("inline_recipe"); ok && !json.Valid([]byte(v.(string))) {
+			format = greengrassv2.RecipeOutputFormatYaml
+		}
+
+		recipe, err := findComponentRecipeByARN(ctx, conn, d.Id(), format)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s) recipe: %s", d.Id(), err)
+		}
+
+		d.Set("inline_recipe", string(recipe))
+	}
+
+	return diags
+}
+
+func resourceComponentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceComponentVersionRead(ctx, d, meta)
+}
+
+func resourceComponentVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	log.Printf("[INFO] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponentWithContext(ctx, &greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindComponentVersionByARN(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) (*greengrassv2.DescribeComponentOutput, error) {
+	input := &greengrassv2.DescribeComponentInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeComponentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findComponentRecipeByARN(ctx context.Context, conn *greengrassv2.GreengrassV2, arn, format string) ([]byte, error) {
+	input := &greengrassv2.GetComponentInput{
+		Arn:                aws.String(arn),
+		RecipeOutputFormat: aws.String(format),
+	}
+
+	output, err := conn.GetComponentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Recipe) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Recipe, nil
+}
+
+func statusComponentVersion(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.ComponentState), nil
+	}
+}
+
+func waitComponentVersionDeployable(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{greengrassv2.CloudComponentStateRequested, greengrassv2.CloudComponentStateInitiated},
+		Target:  []string{greengrassv2.CloudComponentStateDeployable},
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandLambdaFunctionRecipeSource(tfMap map[string]interface{}) *greengrassv2.LambdaFunctionRecipeSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaFunctionRecipeSource{
+		LambdaArn: aws.String(tfMap["lambda_arn"].(string)),
+	}
+
+	if v, ok := tfMap["component_dependency"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentDependencies = make(map[string]*greengrassv2.ComponentDependencyRequirement)
+
+		for _, v := range v.List() {
+			tfMap := v.(map[string]interface{})
+			requirement := &greengrassv2.ComponentDependencyRequirement{}
+
+			if v, ok := tfMap["dependency_type"].(string); ok && v != "" {
+				requirement.DependencyType = aws.String(v)
+			}
+
+			if v, ok := tfMap["version_requirement"].(string); ok && v != "" {
+				requirement.VersionRequirement = aws.String(v)
+			}
+
+			apiObject.ComponentDependencies[tfMap["component_name"].(string)] = requirement
+		}
+	}
+
+	if v, ok := tfMap["component_lambda_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ComponentLambdaParameters = expandLambdaExecutionParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["component_name"].(string); ok && v != "" {
+		apiObject.ComponentName = aws.String(v)
+	}
+
+	for _, v := range tfMap["component_platform"].([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		platform := &greengrassv2.ComponentPlatform{}
+
+		if v, ok := tfMap["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+			platform.Attributes = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			platform.Name = aws.String(v)
+		}
+
+		apiObject.ComponentPlatforms = append(apiObject.ComponentPlatforms, platform)
+	}
+
+	if v, ok := tfMap["component_version"].(string); ok && v != "" {
+		apiObject.ComponentVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLambdaExecutionParameters(tfMap map[string]interface{}) *greengrassv2.LambdaExecutionParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaExecutionParameters{}
+
+	if v, ok := tfMap["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.EnvironmentVariables = flex.ExpandStringMap(v)
+	}
+
+	for _, v := range tfMap["event_source"].([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.EventSources = append(apiObject.EventSources, &greengrassv2.LambdaEventSource{
+			Topic: aws.String(tfMap["topic"].(string)),
+			Type:  aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	if v, ok := tfMap["exec_args"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ExecArgs = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["input_payload_encoding_type"].(string); ok && v != "" {
+		apiObject.InputPayloadEncodingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["linux_process_params"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LinuxProcessParams = expandLambdaLinuxProcessParams(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["max_idle_time_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaxIdleTimeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_instances_count"].(int); ok && v != 0 {
+		apiObject.MaxInstancesCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_queue_size"].(int); ok && v != 0 {
+		apiObject.MaxQueueSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pinned"].(bool); ok {
+		apiObject.Pinned = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["status_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.StatusTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLambdaLinuxProcessParams(tfMap map[string]interface{}) *greengrassv2.LambdaLinuxProcessParams {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaLinuxProcessParams{}
+
+	if v, ok := tfMap["container_params"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		containerParams := &greengrassv2.LambdaContainerParams{}
+
+		for _, v := range tfMap["device"].([]interface{}) {
+			tfMap, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			device := &greengrassv2.LambdaDeviceMount{
+				AddGroupOwner: aws.Bool(tfMap["add_group_owner"].(bool)),
+				Path:          aws.String(tfMap["path"].(string)),
+			}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				device.Permission = aws.String(v)
+			}
+
+			containerParams.Devices = append(containerParams.Devices, device)
+		}
+
+		if v, ok := tfMap["memory_size_in_kb"].(int); ok && v != 0 {
+			containerParams.MemorySizeInKB = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["mount_ro_sysfs"].(bool); ok {
+			containerParams.MountROSysfs = aws.Bool(v)
+		}
+
+		for _, v := range tfMap["volume"].([]interface{}) {
+			tfMap, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			volume := &greengrassv2.LambdaVolumeMount{
+				AddGroupOwner:   aws.Bool(tfMap["add_group_owner"].(bool)),
+				DestinationPath: aws.String(tfMap["destination_path"].(string)),
+				SourcePath:      aws.String(tfMap["source_path"].(string)),
+			}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				volume.Permission = aws.String(v)
+			}
+
+			containerParams.Volumes = append(containerParams.Volumes, volume)
+		}
+
+		apiObject.ContainerParams = containerParams
+	}
+
+	if v, ok := tfMap["isolation_mode"].(string); ok && v != "" {
+		apiObject.IsolationMode = aws.String(v)
+	}
+
+	return apiObject
+}
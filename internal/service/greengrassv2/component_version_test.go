@@ -0,0 +1,307 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2ComponentVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`components:.+:versions:1\.0\.0$`)),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttrSet(resourceName, "inline_recipe"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_function.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "publisher", "Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", greengrassv2.CloudComponentStateDeployable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgreengrassv2.ResourceComponentVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_yamlRecipe(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_yamlRecipe(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentVersionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_greengrassv2_component_version" {
+				continue
+			}
+
+			_, err := tfgreengrassv2.FindComponentVersionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Greengrass V2 Component Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentVersionExists(ctx context.Context, n string, v *greengrassv2.DescribeComponentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Component Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		output, err := tfgreengrassv2.FindComponentVersionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+	input := &greengrassv2.ListComponentsInput{}
+	_, err := conn.ListComponentsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccComponentVersionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion  = "2020-01-25"
+    ComponentName        = %[1]q
+    ComponentVersion     = "1.0.0"
+    ComponentDescription = "Terraform acceptance test"
+    ComponentPublisher   = "Terraform"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccComponentVersionConfig_yamlRecipe(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = <<EOT
+RecipeFormatVersion: "2020-01-25"
+ComponentName: %[1]s
+ComponentVersion: "1.0.0"
+ComponentDescription: Terraform acceptance test
+ComponentPublisher: Terraform
+Manifests:
+  - Platform:
+      os: linux
+    Lifecycle:
+      Run: echo Hello
+EOT
+}
+`, rName)
+}
+
+func testAccComponentVersionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion  = "2020-01-25"
+    ComponentName        = %[1]q
+    ComponentVersion     = "1.0.0"
+    ComponentDescription = "Terraform acceptance test"
+    ComponentPublisher   = "Terraform"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccComponentVersionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion  = "2020-01-25"
+    ComponentName        = %[1]q
+    ComponentVersion     = "1.0.0"
+    ComponentDescription = "Terraform acceptance test"
+    ComponentPublisher   = "Terraform"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,132 @@
+package greengrassv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_greengrassv2_core_devices")
+func DataSourceCoreDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCoreDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"core_devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"core_device_thing_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_status_update_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(greengrassv2.CoreDeviceStatus_Values(), false),
+			},
+			"thing_group_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"thing_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCoreDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	input := &greengrassv2.ListCoreDevicesInput{}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("thing_group_arn"); ok {
+		input.ThingGroupArn = aws.String(v.(string))
+	}
+
+	coreDevices, err := findCoreDevices(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Core Devices: %s", err)
+	}
+
+	var tfList []interface{}
+	var thingNames []string
+
+	for _, v := range coreDevices {
+		tfMap := map[string]interface{}{
+			"core_device_thing_name": aws.StringValue(v.CoreDeviceThingName),
+			"status":                 aws.StringValue(v.Status),
+		}
+
+		if v := v.LastStatusUpdateTimestamp; v != nil {
+			tfMap["last_status_update_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+		thingNames = append(thingNames, aws.StringValue(v.CoreDeviceThingName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("core_devices", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting core_devices: %s", err)
+	}
+
+	d.Set("thing_names", thingNames)
+
+	return diags
+}
+
+func findCoreDevices(ctx context.Context, conn *greengrassv2.GreengrassV2, input *greengrassv2.ListCoreDevicesInput) ([]*greengrassv2.CoreDevice, error) {
+	var output []*greengrassv2.CoreDevice
+
+	err := conn.ListCoreDevicesPagesWithContext(ctx, input, func(page *greengrassv2.ListCoreDevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CoreDevices {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
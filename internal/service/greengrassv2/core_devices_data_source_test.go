@@ -0,0 +1,46 @@
+package greengrassv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGreengrassV2CoreDevicesDataSource_thingGroupARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_greengrassv2_core_devices.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDevicesDataSourceConfig_thingGroupARN(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "core_devices.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", greengrassv2.CoreDeviceStatusHealthy),
+					resource.TestCheckResourceAttr(dataSourceName, "thing_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoreDevicesDataSourceConfig_thingGroupARN(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+data "aws_greengrassv2_core_devices" "test" {
+  status          = "HEALTHY"
+  thing_group_arn = aws_iot_thing_group.test.arn
+}
+`, rName)
+}
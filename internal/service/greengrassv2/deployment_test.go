@@ -0,0 +1,336 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2Deployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+	thingGroupResourceName := "aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "greengrass", regexp.MustCompile(`deployments:.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_name":    rName,
+						"component_version": "1.0.0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_status", greengrassv2.DeploymentStatusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "iot_job_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "iot_job_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_arn", thingGroupResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgreengrassv2.ResourceDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_policiesAndJobConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_policiesAndJobConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"configuration_update.#":       "1",
+						"configuration_update.0.merge": `{"Message":"Hello"}`,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.action", greengrassv2.DeploymentComponentUpdatePolicyActionSkipNotifyComponents),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.timeout_in_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.failure_handling_policy", greengrassv2.DeploymentFailureHandlingPolicyDoNothing),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.maximum_per_minute", "10"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.timeout_config.0.in_progress_timeout_in_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_greengrassv2_deployment" {
+				continue
+			}
+
+			_, err := tfgreengrassv2.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Greengrass V2 Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *greengrassv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Deployment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn()
+
+		output, err := tfgreengrassv2.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[1]q
+    ComponentVersion    = "1.0.0"
+    ComponentConfiguration = {
+      DefaultConfiguration = {
+        Message = "World"
+      }
+    }
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello {configuration:/Message}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccDeploymentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_policiesAndJobConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+
+    configuration_update {
+      merge = jsonencode({
+        Message = "Hello"
+      })
+    }
+  }
+
+  deployment_policies {
+    failure_handling_policy = "DO_NOTHING"
+
+    component_update_policy {
+      action             = "SKIP_NOTIFY_COMPONENTS"
+      timeout_in_seconds = 120
+    }
+  }
+
+  iot_job_configuration {
+    job_executions_rollout_config {
+      maximum_per_minute = 10
+    }
+
+    timeout_config {
+      in_progress_timeout_in_minutes = 60
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDeploymentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,137 @@
+//go:build sweep
+// +build sweep
+
+package greengrassv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_greengrassv2_component_version", &resource.Sweeper{
+		Name: "aws_greengrassv2_component_version",
+		F:    sweepComponentVersions,
+		Dependencies: []string{
+			"aws_greengrassv2_deployment",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_greengrassv2_deployment", &resource.Sweeper{
+		Name: "aws_greengrassv2_deployment",
+		F:    sweepDeployments,
+	})
+}
+
+func sweepComponentVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GreengrassV2Conn()
+	input := &greengrassv2.ListComponentsInput{
+		Scope: aws.String(greengrassv2.ComponentVisibilityScopePrivate),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListComponentsPagesWithContext(ctx, input, func(page *greengrassv2.ListComponentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Components {
+			err := conn.ListComponentVersionsPagesWithContext(ctx, &greengrassv2.ListComponentVersionsInput{
+				Arn: v.Arn,
+			}, func(page *greengrassv2.ListComponentVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ComponentVersions {
+					r := ResourceComponentVersion()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.Arn))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Greengrass V2 Component (%s) Versions (%s): %w", aws.StringValue(v.ComponentName), region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Greengrass V2 Component Version sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Greengrass V2 Components (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Greengrass V2 Component Versions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDeployments(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GreengrassV2Conn()
+	input := &greengrassv2.ListDeploymentsInput{
+		HistoryFilter: aws.String(greengrassv2.DeploymentHistoryFilterAll),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListDeploymentsPagesWithContext(ctx, input, func(page *greengrassv2.ListDeploymentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Deployments {
+			r := ResourceDeployment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DeploymentId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Greengrass V2 Deployment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Greengrass V2 Deployments (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Greengrass V2 Deployments (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -17,13 +17,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -49,7 +49,11 @@ func sweepDetectors(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting GuardDuty Detector: %s", id)
-			_, err := conn.DeleteDetectorWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_guardduty_detector", id, func() error {
+				_, err := conn.DeleteDetectorWithContext(ctx, input)
+
+				return err
+			})
 			if tfawserr.ErrCodeContains(err, "AccessDenied") {
 				log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", id, err)
 				continue
@@ -103,7 +107,11 @@ func sweepPublishingDestinations(region string) error {
 					}
 
 					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
-					_, err := conn.DeletePublishingDestinationWithContext(ctx, input)
+					err := sweep.DeleteFunc(ctx, client, "aws_guardduty_publishing_destination", fmt.Sprintf("%s:%s", aws.StringValue(detectorID), aws.StringValue(destination_element.DestinationId)), func() error {
+						_, err := conn.DeletePublishingDestinationWithContext(ctx, input)
+
+						return err
+					})
 
 					if err != nil {
 						sweeperErr := fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %w", *destination_element.DestinationId, err)
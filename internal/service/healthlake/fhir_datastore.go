@@ -0,0 +1,513 @@
+package healthlake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+func ResourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFHIRDatastoreCreate,
+		ReadWithoutTimeout:   resourceFHIRDatastoreRead,
+		UpdateWithoutTimeout: resourceFHIRDatastoreUpdate,
+		DeleteWithoutTimeout: resourceFHIRDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`), ""),
+				),
+			},
+			"datastore_type_version": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.FHIRVersion](),
+			},
+			"identity_provider_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_strategy": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AuthorizationStrategy](),
+						},
+						"fine_grained_authorization_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"idp_lambda_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"metadata": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+						},
+					},
+				},
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.PreloadDataType](),
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.CmkType](),
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 400),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceFHIRDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthLakeClient()
+
+	input := &healthlake.CreateFHIRDatastoreInput{
+		DatastoreTypeVersion: types.FHIRVersion(d.Get("datastore_type_version").(string)),
+		Tags:                 GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("datastore_name"); ok {
+		input.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("identity_provider_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IdentityProviderConfiguration = expandIdentityProviderConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("preload_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PreloadDataConfig = expandPreloadDataConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfiguration = expandSSEConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateFHIRDatastore(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating HealthLake FHIR Datastore: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.DatastoreId))
+
+	if _, err := waitFHIRDatastoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for HealthLake FHIR Datastore (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFHIRDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceFHIRDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthLakeClient()
+
+	datastore, err := FindFHIRDatastoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	if err := setFHIRDatastore(d, datastore); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceFHIRDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceFHIRDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceFHIRDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthLakeClient()
+
+	log.Printf("[INFO] Deleting HealthLake FHIR Datastore: %s", d.Id())
+	_, err := conn.DeleteFHIRDatastore(ctx, &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for HealthLake FHIR Datastore (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// setFHIRDatastore sets the attributes shared by the resource and data source.
+func setFHIRDatastore(d *schema.ResourceData, datastore *types.DatastoreProperties) error {
+	d.Set("arn", datastore.DatastoreArn)
+	if datastore.CreatedAt != nil {
+		d.Set("created_at", aws.ToTime(datastore.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("datastore_endpoint", datastore.DatastoreEndpoint)
+	d.Set("datastore_name", datastore.DatastoreName)
+	d.Set("datastore_type_version", datastore.DatastoreTypeVersion)
+	if datastore.IdentityProviderConfiguration != nil {
+		if err := d.Set("identity_provider_configuration", []interface{}{flattenIdentityProviderConfiguration(datastore.IdentityProviderConfiguration)}); err != nil {
+			return fmt.Errorf("setting identity_provider_configuration: %w", err)
+		}
+	} else {
+		d.Set("identity_provider_configuration", nil)
+	}
+	if datastore.PreloadDataConfig != nil {
+		if err := d.Set("preload_data_config", []interface{}{flattenPreloadDataConfig(datastore.PreloadDataConfig)}); err != nil {
+			return fmt.Errorf("setting preload_data_config: %w", err)
+		}
+	} else {
+		d.Set("preload_data_config", nil)
+	}
+	if datastore.SseConfiguration != nil {
+		if err := d.Set("sse_configuration", []interface{}{flattenSSEConfiguration(datastore.SseConfiguration)}); err != nil {
+			return fmt.Errorf("setting sse_configuration: %w", err)
+		}
+	} else {
+		d.Set("sse_configuration", nil)
+	}
+
+	return nil
+}
+
+func FindFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*types.DatastoreProperties, error) {
+	input := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeFHIRDatastore(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted datastores remain visible for some time.
+	if status := output.DatastoreProperties.DatastoreStatus; status == types.DatastoreStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DatastoreStatus), nil
+	}
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*types.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DatastoreStatusCreating),
+		Target:  enum.Slice(types.DatastoreStatusActive),
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*types.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DatastoreStatusActive, types.DatastoreStatusDeleting),
+		Target:  []string{},
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandIdentityProviderConfiguration(tfMap map[string]interface{}) *types.IdentityProviderConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.IdentityProviderConfiguration{}
+
+	if v, ok := tfMap["authorization_strategy"].(string); ok && v != "" {
+		apiObject.AuthorizationStrategy = types.AuthorizationStrategy(v)
+	}
+
+	if v, ok := tfMap["fine_grained_authorization_enabled"].(bool); ok {
+		apiObject.FineGrainedAuthorizationEnabled = v
+	}
+
+	if v, ok := tfMap["idp_lambda_arn"].(string); ok && v != "" {
+		apiObject.IdpLambdaArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata"].(string); ok && v != "" {
+		apiObject.Metadata = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPreloadDataConfig(tfMap map[string]interface{}) *types.PreloadDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PreloadDataConfig{}
+
+	if v, ok := tfMap["preload_data_type"].(string); ok && v != "" {
+		apiObject.PreloadDataType = types.PreloadDataType(v)
+	}
+
+	return apiObject
+}
+
+func expandSSEConfiguration(tfMap map[string]interface{}) *types.SseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KmsEncryptionConfig = expandKMSEncryptionConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandKMSEncryptionConfig(tfMap map[string]interface{}) *types.KmsEncryptionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.KmsEncryptionConfig{}
+
+	if v, ok := tfMap["cmk_type"].(string); ok && v != "" {
+		apiObject.CmkType = types.CmkType(v)
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIdentityProviderConfiguration(apiObject *types.IdentityProviderConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"authorization_strategy":             string(apiObject.AuthorizationStrategy),
+		"fine_grained_authorization_enabled": apiObject.FineGrainedAuthorizationEnabled,
+	}
+
+	if v := apiObject.IdpLambdaArn; v != nil {
+		tfMap["idp_lambda_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Metadata; v != nil {
+		tfMap["metadata"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenPreloadDataConfig(apiObject *types.PreloadDataConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"preload_data_type": string(apiObject.PreloadDataType),
+	}
+
+	return tfMap
+}
+
+func flattenSSEConfiguration(apiObject *types.SseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		tfMap["kms_encryption_config"] = []interface{}{flattenKMSEncryptionConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenKMSEncryptionConfig(apiObject *types.KmsEncryptionConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cmk_type": string(apiObject.CmkType),
+	}
+
+	if v := apiObject.KmsKeyId; v != nil {
+		tfMap["kms_key_id"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,144 @@
+package healthlake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_healthlake_fhir_datastore")
+func DataSourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFHIRDatastoreRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datastore_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_type_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_provider_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_strategy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fine_grained_authorization_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"idp_lambda_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceFHIRDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).HealthLakeClient()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	datastore, err := FindFHIRDatastoreByID(ctx, conn, d.Get("datastore_id").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("HealthLake FHIR Datastore", err))
+	}
+
+	d.SetId(aws.ToString(datastore.DatastoreId))
+	if err := setFHIRDatastore(d, datastore); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading HealthLake FHIR Datastore (%s): %s", d.Id(), err)
+	}
+	d.Set("datastore_status", datastore.DatastoreStatus)
+
+	arn := aws.ToString(datastore.DatastoreArn)
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for HealthLake FHIR Datastore (%s): %s", arn, err)
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
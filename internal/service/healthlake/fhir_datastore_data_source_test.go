@@ -0,0 +1,52 @@
+package healthlake_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeFHIRDatastoreDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_healthlake_fhir_datastore.test"
+	resourceName := "aws_healthlake_fhir_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.HealthLakeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "datastore_endpoint", resourceName, "datastore_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "datastore_name", resourceName, "datastore_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "datastore_status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "datastore_type_version", resourceName, "datastore_type_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sse_configuration.#", resourceName, "sse_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.key1", resourceName, "tags.key1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFHIRDatastoreDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRDatastoreConfig_tags1(rName, "key1", "value1"), `
+data "aws_healthlake_fhir_datastore" "test" {
+  datastore_id = aws_healthlake_fhir_datastore.test.id
+}
+`)
+}
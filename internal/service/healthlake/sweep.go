@@ -2,3 +2,70 @@
 // +build sweep
 
 package healthlake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_healthlake_fhir_datastore", &resource.Sweeper{
+		Name: "aws_healthlake_fhir_datastore",
+		F:    sweepFHIRDatastores,
+	})
+}
+
+func sweepFHIRDatastores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).HealthLakeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := healthlake.NewListFHIRDatastoresPaginator(conn, &healthlake.ListFHIRDatastoresInput{
+		Filter: &types.DatastoreFilter{
+			DatastoreStatus: types.DatastoreStatusActive,
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping HealthLake FHIR Datastore sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing HealthLake FHIR Datastores for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range page.DatastorePropertiesList {
+			r := ResourceFHIRDatastore()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.DatastoreId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping HealthLake FHIR Datastores for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -4,6 +4,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -19,7 +20,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -27,18 +28,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
 		Name:         "aws_iam_instance_profile",
 		F:            sweepInstanceProfile,
 		Dependencies: []string{"aws_iam_role"},
 	})
 
-	resource.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
 		Name: "aws_iam_openid_connect_provider",
 		F:    sweepOpenIDConnectProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -48,7 +49,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -78,32 +79,32 @@ func init() {
 		F: sweepRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
 		Name: "aws_iam_saml_provider",
 		F:    sweepSAMLProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_specific_credential", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_specific_credential", &resource.Sweeper{
 		Name: "aws_iam_service_specific_credential",
 		F:    sweepServiceSpecificCredentials,
 	})
 
-	resource.AddTestSweepers("aws_iam_signing_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_signing_certificate", &resource.Sweeper{
 		Name: "aws_iam_signing_certificate",
 		F:    sweepSigningCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    sweepServiceLinkedRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -114,7 +115,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_virtual_mfa_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_virtual_mfa_device", &resource.Sweeper{
 		Name: "aws_iam_virtual_mfa_device",
 		F:    sweepVirtualMFADevice,
 	})
@@ -163,7 +164,7 @@ func sweepGroups(region string) error {
 				continue
 			}
 
-			if getGroupOutput != nil {
+			err = sweep.DeleteFunc(ctx, client, "aws_iam_group", name, func() error {
 				for _, user := range getGroupOutput.Users {
 					username := aws.StringValue(user.UserName)
 
@@ -181,42 +182,38 @@ func sweepGroups(region string) error {
 					}
 
 					if err != nil {
-						sweeperErr := fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
+						return fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
 					}
 				}
-			}
 
-			input := &iam.DeleteGroupInput{
-				GroupName: group.GroupName,
-			}
+				if err := DeleteGroupPolicyAttachments(ctx, conn, name); err != nil {
+					return fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
+				}
 
-			if err := DeleteGroupPolicyAttachments(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err := DeleteGroupPolicies(ctx, conn, name); err != nil {
+					return fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
+				}
 
-			if err := DeleteGroupPolicies(ctx, conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				input := &iam.DeleteGroupInput{
+					GroupName: group.GroupName,
+				}
 
-			_, err = conn.DeleteGroupWithContext(ctx, input)
+				_, err := conn.DeleteGroupWithContext(ctx, input)
 
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
+				if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+					return nil
+				}
+
+				if err != nil {
+					return fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
+				}
+
+				return nil
+			})
 
 			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
 			}
 		}
@@ -427,14 +424,15 @@ func sweepPolicies(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting IAM Policy: %s", arn)
-			if err := policyDeleteNonDefaultVersions(ctx, arn, conn); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s) non-default versions: %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			err := sweep.DeleteFunc(ctx, client, "aws_iam_policy", arn, func() error {
+				if err := policyDeleteNonDefaultVersions(ctx, arn, conn); err != nil {
+					return fmt.Errorf("deleting non-default versions: %w", err)
+				}
+
+				_, err := conn.DeletePolicyWithContext(ctx, input)
 
-			_, err := conn.DeletePolicyWithContext(ctx, input)
+				return err
+			})
 
 			// Treat this sweeper as best effort for now. There are a lot of edge cases
 			// with lingering aws_iam_role resources in the HashiCorp testing accounts.
@@ -515,7 +513,9 @@ func sweepRoles(region string) error {
 	for _, roleName := range roles {
 		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
 
-		err := DeleteRole(ctx, conn, roleName, true, true, true)
+		err := sweep.DeleteFunc(ctx, client, "aws_iam_role", roleName, func() error {
+			return DeleteRole(ctx, conn, roleName, true, true, true)
+		})
 		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 			continue
 		}
@@ -586,8 +586,12 @@ func sweepServerCertificates(region string) error {
 		for _, sc := range out.ServerCertificateMetadataList {
 			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
 
-			_, err := conn.DeleteServerCertificateWithContext(ctx, &iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
+			err := sweep.DeleteFunc(ctx, client, "aws_iam_server_certificate", aws.StringValue(sc.ServerCertificateName), func() error {
+				_, err := conn.DeleteServerCertificateWithContext(ctx, &iam.DeleteServerCertificateInput{
+					ServerCertificateName: sc.ServerCertificateName,
+				})
+
+				return err
 			})
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
@@ -710,128 +714,110 @@ func sweepUsers(region string) error {
 		username := aws.StringValue(user.UserName)
 		log.Printf("[DEBUG] Deleting IAM User: %s", username)
 
-		listUserPoliciesInput := &iam.ListUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listUserPoliciesOutput, err := conn.ListUserPoliciesWithContext(ctx, listUserPoliciesInput)
+		err := sweep.DeleteFunc(ctx, client, "aws_iam_user", username, func() error {
+			return sweepUser(ctx, conn, username)
+		})
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
 		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) inline policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			log.Printf("[ERROR] %s", err)
+			sweeperErrs = multierror.Append(sweeperErrs, err)
 			continue
 		}
+	}
 
-		for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
-			log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, *inlinePolicyName)
+	return sweeperErrs.ErrorOrNil()
+}
 
-			input := &iam.DeleteUserPolicyInput{
-				PolicyName: inlinePolicyName,
-				UserName:   user.UserName,
-			}
+// sweepUser deletes an IAM user after removing its policies, group memberships and credentials.
+func sweepUser(ctx context.Context, conn *iam.IAM, username string) error {
+	listUserPoliciesInput := &iam.ListUserPoliciesInput{
+		UserName: aws.String(username),
+	}
+	listUserPoliciesOutput, err := conn.ListUserPoliciesWithContext(ctx, listUserPoliciesInput)
 
-			if _, err := conn.DeleteUserPolicyWithContext(ctx, input); err != nil {
-				if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-					continue
-				}
-				sweeperErr := fmt.Errorf("error deleting IAM User (%s) inline policy %q: %s", username, *inlinePolicyName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) inline policies: %s", username, err)
+	}
+
+	for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, *inlinePolicyName)
+
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: inlinePolicyName,
+			UserName:   aws.String(username),
+		}
+
+		if _, err := conn.DeleteUserPolicyWithContext(ctx, input); err != nil {
+			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 				continue
 			}
+			return fmt.Errorf("error deleting IAM User (%s) inline policy %q: %s", username, *inlinePolicyName, err)
 		}
+	}
 
-		listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPoliciesWithContext(ctx, listAttachedUserPoliciesInput)
+	listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(username),
+	}
+	listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPoliciesWithContext(ctx, listAttachedUserPoliciesInput)
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
+	}
 
-		for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
-			policyARN := aws.StringValue(attachedPolicy.PolicyArn)
+	for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
+		policyARN := aws.StringValue(attachedPolicy.PolicyArn)
 
-			log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
+		log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
 
-			if err := DetachPolicyFromUser(ctx, conn, username, policyARN); err != nil {
-				sweeperErr := fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		if err := DetachPolicyFromUser(ctx, conn, username, policyARN); err != nil {
+			return fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
 		}
+	}
 
-		if err := DeleteUserGroupMemberships(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserGroupMemberships(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
+	}
 
-		if err := DeleteUserAccessKeys(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserAccessKeys(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
+	}
 
-		if err := DeleteUserSSHKeys(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserSSHKeys(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
+	}
 
-		if err := DeleteUserVirtualMFADevices(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) virtual MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserVirtualMFADevices(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) virtual MFA devices: %s", username, err)
+	}
 
-		if err := DeactivateUserMFADevices(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeactivateUserMFADevices(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
+	}
 
-		if err := DeleteUserLoginProfile(ctx, conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := DeleteUserLoginProfile(ctx, conn, username); err != nil {
+		return fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
+	}
 
-		input := &iam.DeleteUserInput{
-			UserName: aws.String(username),
-		}
+	input := &iam.DeleteUserInput{
+		UserName: aws.String(username),
+	}
 
-		_, err = conn.DeleteUserWithContext(ctx, input)
+	_, err = conn.DeleteUserWithContext(ctx, input)
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM User (%s): %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting IAM User (%s): %s", username, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func roleNameFilter(name string) bool {
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertifcates,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -39,40 +39,40 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
 		Name:         "aws_iot_thing",
 		F:            sweepThings,
 		Dependencies: []string{"aws_iot_thing_principal_attachment"},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
 		Name:         "aws_iot_thing_type",
 		F:            sweepThingTypes,
 		Dependencies: []string{"aws_iot_thing"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
 		Name:         "aws_iot_topic_rule",
 		F:            sweepTopicRules,
 		Dependencies: []string{"aws_iot_topic_rule_destination"},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
@@ -472,8 +472,12 @@ func sweepTopicRules(region string) error {
 			name := aws.StringValue(rule.RuleName)
 
 			log.Printf("[INFO] Deleting IoT Topic Rule: %s", name)
-			_, err := conn.DeleteTopicRuleWithContext(ctx, &iot.DeleteTopicRuleInput{
-				RuleName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_iot_topic_rule", name, func() error {
+				_, err := conn.DeleteTopicRuleWithContext(ctx, &iot.DeleteTopicRuleInput{
+					RuleName: aws.String(name),
+				})
+
+				return err
 			})
 			if tfawserr.ErrCodeEquals(err, iot.ErrCodeUnauthorizedException) {
 				continue
@@ -0,0 +1,308 @@
+package iotanalytics
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_channel", name="Channel")
+// @Tags(identifierAttribute="arn")
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelCreate,
+		ReadWithoutTimeout:   resourceChannelRead,
+		UpdateWithoutTimeout: resourceChannelUpdate,
+		DeleteWithoutTimeout: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_s3": customerManagedS3Schema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			names.AttrTags:     tftags.TagsSchema(),
+			names.AttrTagsAll:  tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+)
+
+func customerManagedS3Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 255),
+				},
+				"key_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexp.MustCompile(`/$`), "must end with a slash"),
+					),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func retentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"retention_period.0.unlimited"},
+				},
+				"unlimited": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ConflictsWith: []string{"retention_period.0.number_of_days"},
+				},
+			},
+		},
+	}
+}
+
+func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName:    aws.String(name),
+		ChannelStorage: expandChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+		Tags:           GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateChannelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Channel (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceChannelRead(ctx, d, meta)...)
+}
+
+func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	channel, err := FindChannelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", channel.Arn)
+	if channel.Storage != nil && channel.Storage.CustomerManagedS3 != nil {
+		if err := d.Set("customer_managed_s3", []interface{}{flattenCustomerManagedChannelS3Storage(channel.Storage.CustomerManagedS3)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting customer_managed_s3: %s", err)
+		}
+	} else {
+		d.Set("customer_managed_s3", nil)
+	}
+	d.Set("name", channel.Name)
+	if channel.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(channel.RetentionPeriod)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	return diags
+}
+
+func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName:    aws.String(d.Id()),
+			ChannelStorage: expandChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateChannelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Channel (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceChannelRead(ctx, d, meta)...)
+}
+
+func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	log.Printf("[INFO] Deleting IoT Analytics Channel: %s", d.Id())
+	_, err := conn.DeleteChannelWithContext(ctx, &iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindChannelByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Channel, error) {
+	input := &iotanalytics.DescribeChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Channel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Channel, nil
+}
+
+func expandChannelStorage(tfList []interface{}) *iotanalytics.ChannelStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &iotanalytics.ChannelStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedChannelS3Storage{},
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.CustomerManagedChannelS3Storage{
+		Bucket:  aws.String(tfMap["bucket"].(string)),
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return &iotanalytics.ChannelStorage{
+		CustomerManagedS3: apiObject,
+	}
+}
+
+func flattenCustomerManagedChannelS3Storage(apiObject *iotanalytics.CustomerManagedChannelS3Storage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"bucket":     aws.StringValue(apiObject.Bucket),
+		"key_prefix": aws.StringValue(apiObject.KeyPrefix),
+		"role_arn":   aws.StringValue(apiObject.RoleArn),
+	}
+}
+
+func expandRetentionPeriod(tfMap map[string]interface{}) *iotanalytics.RetentionPeriod {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v != 0 {
+		apiObject.NumberOfDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenRetentionPeriod(apiObject *iotanalytics.RetentionPeriod) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"number_of_days": aws.Int64Value(apiObject.NumberOfDays),
+		"unlimited":      aws.BoolValue(apiObject.Unlimited),
+	}
+}
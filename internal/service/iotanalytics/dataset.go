@@ -0,0 +1,714 @@
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_dataset", name="Dataset")
+// @Tags(identifierAttribute="arn")
+func ResourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"execution_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"resource_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iotanalytics.ComputeType_Values(), false),
+												},
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+											},
+										},
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dataset_content_version_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dataset_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validName,
+															},
+														},
+													},
+												},
+												"double_value": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"output_file_uri_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"file_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+														},
+													},
+												},
+												"string_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"query_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delta_time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"offset_seconds": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"time_expression": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sql_query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			names.AttrTags:     tftags.TagsSchema(),
+			names.AttrTagsAll:  tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 1000),
+							ConflictsWith: []string{"versioning_configuration.0.unlimited"},
+						},
+						"unlimited": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"versioning_configuration.0.max_versions"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatasetInput{
+		Actions:     expandDatasetActions(d.Get("action").([]interface{})),
+		DatasetName: aws.String(name),
+		Tags:        GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		input.Triggers = expandDatasetTriggers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("versioning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VersioningConfiguration = expandVersioningConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateDatasetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	dataset, err := FindDatasetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenDatasetActions(dataset.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting action: %s", err)
+	}
+	d.Set("arn", dataset.Arn)
+	d.Set("name", dataset.Name)
+	if dataset.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(dataset.RetentionPeriod)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+	if err := d.Set("trigger", flattenDatasetTriggers(dataset.Triggers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting trigger: %s", err)
+	}
+	if dataset.VersioningConfiguration != nil {
+		if err := d.Set("versioning_configuration", []interface{}{flattenVersioningConfiguration(dataset.VersioningConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting versioning_configuration: %s", err)
+		}
+	} else {
+		d.Set("versioning_configuration", nil)
+	}
+
+	return diags
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateDatasetInput{
+			Actions:     expandDatasetActions(d.Get("action").([]interface{})),
+			DatasetName: aws.String(d.Id()),
+			Triggers:    expandDatasetTriggers(d.Get("trigger").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("versioning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.VersioningConfiguration = expandVersioningConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateDatasetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Dataset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	log.Printf("[INFO] Deleting IoT Analytics Dataset: %s", d.Id())
+	_, err := conn.DeleteDatasetWithContext(ctx, &iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDatasetByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Dataset, error) {
+	input := &iotanalytics.DescribeDatasetInput{
+		DatasetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatasetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dataset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dataset, nil
+}
+
+func expandDatasetActions(tfList []interface{}) []*iotanalytics.DatasetAction {
+	var apiObjects []*iotanalytics.DatasetAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetAction{
+			ActionName: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["container_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContainerAction = expandContainerDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["query_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.QueryAction = expandSQLQueryDatasetAction(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDatasetAction(tfMap map[string]interface{}) *iotanalytics.ContainerDatasetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.ContainerDatasetAction{
+		ExecutionRoleArn: aws.String(tfMap["execution_role_arn"].(string)),
+		Image:            aws.String(tfMap["image"].(string)),
+	}
+
+	if v, ok := tfMap["resource_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ResourceConfiguration = &iotanalytics.ResourceConfiguration{
+			ComputeType:    aws.String(tfMap["compute_type"].(string)),
+			VolumeSizeInGB: aws.Int64(int64(tfMap["volume_size_in_gb"].(int))),
+		}
+	}
+
+	for _, v := range tfMap["variable"].([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		variable := &iotanalytics.Variable{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["dataset_content_version_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.DatasetContentVersionValue = &iotanalytics.DatasetContentVersionValue{
+				DatasetName: aws.String(v[0].(map[string]interface{})["dataset_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+			variable.DoubleValue = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["output_file_uri_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.OutputFileUriValue = &iotanalytics.OutputFileUriValue{
+				FileName: aws.String(v[0].(map[string]interface{})["file_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			variable.StringValue = aws.String(v)
+		}
+
+		apiObject.Variables = append(apiObject.Variables, variable)
+	}
+
+	return apiObject
+}
+
+func expandSQLQueryDatasetAction(tfMap map[string]interface{}) *iotanalytics.SqlQueryDatasetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.SqlQueryDatasetAction{
+		SqlQuery: aws.String(tfMap["sql_query"].(string)),
+	}
+
+	for _, v := range tfMap["filter"].([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filter := &iotanalytics.QueryFilter{}
+
+		if v, ok := tfMap["delta_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			filter.DeltaTime = &iotanalytics.DeltaTime{
+				OffsetSeconds:  aws.Int64(int64(tfMap["offset_seconds"].(int))),
+				TimeExpression: aws.String(tfMap["time_expression"].(string)),
+			}
+		}
+
+		apiObject.Filters = append(apiObject.Filters, filter)
+	}
+
+	return apiObject
+}
+
+func expandDatasetTriggers(tfList []interface{}) []*iotanalytics.DatasetTrigger {
+	apiObjects := []*iotanalytics.DatasetTrigger{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetTrigger{}
+
+		if v, ok := tfMap["dataset"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Dataset = &iotanalytics.TriggeringDataset{
+				Name: aws.String(v[0].(map[string]interface{})["name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["schedule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Schedule = &iotanalytics.Schedule{
+				Expression: aws.String(v[0].(map[string]interface{})["expression"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVersioningConfiguration(tfMap map[string]interface{}) *iotanalytics.VersioningConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.VersioningConfiguration{}
+
+	if v, ok := tfMap["max_versions"].(int); ok && v != 0 {
+		apiObject.MaxVersions = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenDatasetActions(apiObjects []*iotanalytics.DatasetAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.ActionName),
+		}
+
+		if v := apiObject.ContainerAction; v != nil {
+			tfMap["container_action"] = []interface{}{flattenContainerDatasetAction(v)}
+		}
+
+		if v := apiObject.QueryAction; v != nil {
+			tfMap["query_action"] = []interface{}{flattenSQLQueryDatasetAction(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDatasetAction(apiObject *iotanalytics.ContainerDatasetAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"execution_role_arn": aws.StringValue(apiObject.ExecutionRoleArn),
+		"image":              aws.StringValue(apiObject.Image),
+	}
+
+	if v := apiObject.ResourceConfiguration; v != nil {
+		tfMap["resource_configuration"] = []interface{}{map[string]interface{}{
+			"compute_type":      aws.StringValue(v.ComputeType),
+			"volume_size_in_gb": aws.Int64Value(v.VolumeSizeInGB),
+		}}
+	}
+
+	var variables []interface{}
+
+	for _, v := range apiObject.Variables {
+		if v == nil {
+			continue
+		}
+
+		variable := map[string]interface{}{
+			"double_value": aws.Float64Value(v.DoubleValue),
+			"name":         aws.StringValue(v.Name),
+			"string_value": aws.StringValue(v.StringValue),
+		}
+
+		if v := v.DatasetContentVersionValue; v != nil {
+			variable["dataset_content_version_value"] = []interface{}{map[string]interface{}{
+				"dataset_name": aws.StringValue(v.DatasetName),
+			}}
+		}
+
+		if v := v.OutputFileUriValue; v != nil {
+			variable["output_file_uri_value"] = []interface{}{map[string]interface{}{
+				"file_name": aws.StringValue(v.FileName),
+			}}
+		}
+
+		variables = append(variables, variable)
+	}
+
+	tfMap["variable"] = variables
+
+	return tfMap
+}
+
+func flattenSQLQueryDatasetAction(apiObject *iotanalytics.SqlQueryDatasetAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sql_query": aws.StringValue(apiObject.SqlQuery),
+	}
+
+	var filters []interface{}
+
+	for _, v := range apiObject.Filters {
+		if v == nil {
+			continue
+		}
+
+		filter := map[string]interface{}{}
+
+		if v := v.DeltaTime; v != nil {
+			filter["delta_time"] = []interface{}{map[string]interface{}{
+				"offset_seconds":  aws.Int64Value(v.OffsetSeconds),
+				"time_expression": aws.StringValue(v.TimeExpression),
+			}}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	tfMap["filter"] = filters
+
+	return tfMap
+}
+
+func flattenDatasetTriggers(apiObjects []*iotanalytics.DatasetTrigger) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Dataset; v != nil {
+			tfMap["dataset"] = []interface{}{map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.Schedule; v != nil {
+			tfMap["schedule"] = []interface{}{map[string]interface{}{
+				"expression": aws.StringValue(v.Expression),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVersioningConfiguration(apiObject *iotanalytics.VersioningConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"max_versions": aws.Int64Value(apiObject.MaxVersions),
+		"unlimited":    aws.BoolValue(apiObject.Unlimited),
+	}
+}
@@ -0,0 +1,319 @@
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsDataset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.container_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.name", "query"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "action.0.query_action.0.sql_query"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(`dataset/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceDataset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Dataset
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.offset_seconds", "-60"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.time_expression", "from_unixtime(time)"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.schedule.0.expression", "cron(0 12 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.max_versions", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_dataset" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Dataset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatasetExists(ctx context.Context, n string, v *iotanalytics.Dataset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Dataset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn()
+
+		output, err := tfiotanalytics.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatasetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatasetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDatasetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDatasetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccDatasetConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+
+      filter {
+        delta_time {
+          offset_seconds  = -60
+          time_expression = "from_unixtime(time)"
+        }
+      }
+    }
+  }
+
+  retention_period {
+    number_of_days = 7
+  }
+
+  trigger {
+    schedule {
+      expression = "cron(0 12 * * ? *)"
+    }
+  }
+
+  versioning_configuration {
+    max_versions = 5
+  }
+}
+`, rName))
+}
@@ -0,0 +1,340 @@
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_datastore", name="Datastore")
+// @Tags(identifierAttribute="arn")
+func ResourceDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatastoreCreate,
+		ReadWithoutTimeout:   resourceDatastoreRead,
+		UpdateWithoutTimeout: resourceDatastoreUpdate,
+		DeleteWithoutTimeout: resourceDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_s3": customerManagedS3Schema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			// The file format of a data store can't be changed after creation.
+			"parquet_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schema_definition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 100,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 131072),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retention_period": retentionPeriodSchema(),
+			names.AttrTags:     tftags.TagsSchema(),
+			names.AttrTagsAll:  tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName:    aws.String(name),
+		DatastoreStorage: expandDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+		Tags:             GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("parquet_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.FileFormatConfiguration = &iotanalytics.FileFormatConfiguration{
+			ParquetConfiguration: expandParquetConfiguration(v.([]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateDatastoreWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Datastore (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	datastore, err := FindDatastoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", datastore.Arn)
+	if datastore.Storage != nil && datastore.Storage.CustomerManagedS3 != nil {
+		if err := d.Set("customer_managed_s3", []interface{}{flattenCustomerManagedDatastoreS3Storage(datastore.Storage.CustomerManagedS3)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting customer_managed_s3: %s", err)
+		}
+	} else {
+		d.Set("customer_managed_s3", nil)
+	}
+	d.Set("name", datastore.Name)
+	if datastore.FileFormatConfiguration != nil && datastore.FileFormatConfiguration.ParquetConfiguration != nil {
+		if err := d.Set("parquet_configuration", flattenParquetConfiguration(datastore.FileFormatConfiguration.ParquetConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting parquet_configuration: %s", err)
+		}
+	} else {
+		d.Set("parquet_configuration", nil)
+	}
+	if datastore.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(datastore.RetentionPeriod)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	return diags
+}
+
+func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName:    aws.String(d.Id()),
+			DatastoreStorage: expandDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("parquet_configuration"); ok && len(v.([]interface{})) > 0 {
+			input.FileFormatConfiguration = &iotanalytics.FileFormatConfiguration{
+				ParquetConfiguration: expandParquetConfiguration(v.([]interface{})),
+			}
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateDatastoreWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Datastore (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatastoreRead(ctx, d, meta)...)
+}
+
+func resourceDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	log.Printf("[INFO] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastoreWithContext(ctx, &iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDatastoreByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Datastore, nil
+}
+
+func expandDatastoreStorage(tfList []interface{}) *iotanalytics.DatastoreStorage {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &iotanalytics.DatastoreStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedDatastoreS3Storage{},
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotanalytics.CustomerManagedDatastoreS3Storage{
+		Bucket:  aws.String(tfMap["bucket"].(string)),
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return &iotanalytics.DatastoreStorage{
+		CustomerManagedS3: apiObject,
+	}
+}
+
+func flattenCustomerManagedDatastoreS3Storage(apiObject *iotanalytics.CustomerManagedDatastoreS3Storage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"bucket":     aws.StringValue(apiObject.Bucket),
+		"key_prefix": aws.StringValue(apiObject.KeyPrefix),
+		"role_arn":   aws.StringValue(apiObject.RoleArn),
+	}
+}
+
+func expandParquetConfiguration(tfList []interface{}) *iotanalytics.ParquetConfiguration {
+	apiObject := &iotanalytics.ParquetConfiguration{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["schema_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		schemaDefinition := &iotanalytics.SchemaDefinition{}
+
+		for _, v := range v[0].(map[string]interface{})["column"].([]interface{}) {
+			if v == nil {
+				continue
+			}
+
+			tfMap := v.(map[string]interface{})
+			schemaDefinition.Columns = append(schemaDefinition.Columns, &iotanalytics.Column{
+				Name: aws.String(tfMap["name"].(string)),
+				Type: aws.String(tfMap["type"].(string)),
+			})
+		}
+
+		apiObject.SchemaDefinition = schemaDefinition
+	}
+
+	return apiObject
+}
+
+func flattenParquetConfiguration(apiObject *iotanalytics.ParquetConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SchemaDefinition; v != nil {
+		var columns []interface{}
+
+		for _, v := range v.Columns {
+			if v == nil {
+				continue
+			}
+
+			columns = append(columns, map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+				"type": aws.StringValue(v.Type),
+			})
+		}
+
+		tfMap["schema_definition"] = []interface{}{map[string]interface{}{
+			"column": columns,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,314 @@
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsDatastore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(`datastore/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "customer_managed_s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatastoreConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDatastoreConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_customerManagedS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_customerManagedS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "customer_managed_s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "customer_managed_s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "customer_managed_s3.0.key_prefix", "datastore/"),
+					resource.TestCheckResourceAttrPair(resourceName, "customer_managed_s3.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_parquetConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Datastore
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_datastore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_parquetConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.0.schema_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.0.schema_definition.0.column.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.0.schema_definition.0.column.0.name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.0.schema_definition.0.column.0.type", "string"),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.0.schema_definition.0.column.1.name", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "parquet_configuration.0.schema_definition.0.column.1.type", "double"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatastoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_datastore" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Datastore %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatastoreExists(ctx context.Context, n string, v *iotanalytics.Datastore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Datastore ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn()
+
+		output, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatastoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDatastoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccDatastoreConfig_customerManagedS3(rName string) string {
+	return acctest.ConfigCompose(testAccCustomerManagedS3Config_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  customer_managed_s3 {
+    bucket     = aws_s3_bucket.test.bucket
+    key_prefix = "datastore/"
+    role_arn   = aws_iam_role.test.arn
+  }
+
+  retention_period {
+    unlimited = true
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_bucket_policy.test]
+}
+`, rName))
+}
+
+func testAccDatastoreConfig_parquetConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  parquet_configuration {
+    schema_definition {
+      column {
+        name = "device_id"
+        type = "string"
+      }
+
+      column {
+        name = "temperature"
+        type = "double"
+      }
+    }
+  }
+}
+`, rName)
+}
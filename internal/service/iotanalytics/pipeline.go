@@ -0,0 +1,523 @@
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotanalytics_pipeline", name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func ResourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": pipelineActivitySchema(map[string]*schema.Schema{
+							"attributes": {
+								Type:     schema.TypeMap,
+								Required: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						}),
+						"channel": pipelineActivitySchema(map[string]*schema.Schema{
+							"channel_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validName,
+							},
+						}),
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+						"device_registry_enrich": pipelineActivitySchema(deviceEnrichActivitySchema()),
+						"device_shadow_enrich":   pipelineActivitySchema(deviceEnrichActivitySchema()),
+						"filter": pipelineActivitySchema(map[string]*schema.Schema{
+							"filter": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						}),
+						"lambda": pipelineActivitySchema(map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 1000),
+							},
+							"lambda_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+						}),
+						"math": pipelineActivitySchema(map[string]*schema.Schema{
+							"attribute": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"math": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						}),
+						"remove_attributes": pipelineActivitySchema(map[string]*schema.Schema{
+							"attributes": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 50,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						}),
+						"select_attributes": pipelineActivitySchema(map[string]*schema.Schema{
+							"attributes": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 50,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						}),
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+// pipelineActivitySchema returns the schema of a pipeline activity with the given
+// activity-specific attributes in addition to the common name and next attributes.
+func pipelineActivitySchema(attributes map[string]*schema.Schema) *schema.Schema {
+	attributes["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+	attributes["next"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: attributes,
+		},
+	}
+}
+
+func deviceEnrichActivitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"attribute": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+		"role_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		"thing_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+		Tags:               GetTagsIn(ctx),
+	}
+
+	_, err := conn.CreatePipelineWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Analytics Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	pipeline, err := FindPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenPipelineActivities(pipeline.Activities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting activity: %s", err)
+	}
+	d.Set("arn", pipeline.Arn)
+	d.Set("name", pipeline.Name)
+
+	return diags
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdatePipelineWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Analytics Pipeline (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePipelineRead(ctx, d, meta)...)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn()
+
+	log.Printf("[INFO] Deleting IoT Analytics Pipeline: %s", d.Id())
+	_, err := conn.DeletePipelineWithContext(ctx, &iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPipelineByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipelineWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+func expandPipelineActivities(tfList []interface{}) []*iotanalytics.PipelineActivity {
+	var apiObjects []*iotanalytics.PipelineActivity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.PipelineActivity{}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "add_attributes"); ok {
+			apiObject.AddAttributes = &iotanalytics.AddAttributesActivity{
+				Attributes: flex.ExpandStringMap(v["attributes"].(map[string]interface{})),
+				Name:       aws.String(v["name"].(string)),
+				Next:       expandNextActivity(v),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "channel"); ok {
+			apiObject.Channel = &iotanalytics.ChannelActivity{
+				ChannelName: aws.String(v["channel_name"].(string)),
+				Name:        aws.String(v["name"].(string)),
+				Next:        expandNextActivity(v),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "datastore"); ok {
+			apiObject.Datastore = &iotanalytics.DatastoreActivity{
+				DatastoreName: aws.String(v["datastore_name"].(string)),
+				Name:          aws.String(v["name"].(string)),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "device_registry_enrich"); ok {
+			apiObject.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+				Attribute: aws.String(v["attribute"].(string)),
+				Name:      aws.String(v["name"].(string)),
+				Next:      expandNextActivity(v),
+				RoleArn:   aws.String(v["role_arn"].(string)),
+				ThingName: aws.String(v["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "device_shadow_enrich"); ok {
+			apiObject.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+				Attribute: aws.String(v["attribute"].(string)),
+				Name:      aws.String(v["name"].(string)),
+				Next:      expandNextActivity(v),
+				RoleArn:   aws.String(v["role_arn"].(string)),
+				ThingName: aws.String(v["thing_name"].(string)),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "filter"); ok {
+			apiObject.Filter = &iotanalytics.FilterActivity{
+				Filter: aws.String(v["filter"].(string)),
+				Name:   aws.String(v["name"].(string)),
+				Next:   expandNextActivity(v),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "lambda"); ok {
+			apiObject.Lambda = &iotanalytics.LambdaActivity{
+				BatchSize:  aws.Int64(int64(v["batch_size"].(int))),
+				LambdaName: aws.String(v["lambda_name"].(string)),
+				Name:       aws.String(v["name"].(string)),
+				Next:       expandNextActivity(v),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "math"); ok {
+			apiObject.Math = &iotanalytics.MathActivity{
+				Attribute: aws.String(v["attribute"].(string)),
+				Math:      aws.String(v["math"].(string)),
+				Name:      aws.String(v["name"].(string)),
+				Next:      expandNextActivity(v),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "remove_attributes"); ok {
+			apiObject.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+				Attributes: flex.ExpandStringList(v["attributes"].([]interface{})),
+				Name:       aws.String(v["name"].(string)),
+				Next:       expandNextActivity(v),
+			}
+		}
+
+		if v, ok := pipelineActivityAttributes(tfMap, "select_attributes"); ok {
+			apiObject.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+				Attributes: flex.ExpandStringList(v["attributes"].([]interface{})),
+				Name:       aws.String(v["name"].(string)),
+				Next:       expandNextActivity(v),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func pipelineActivityAttributes(tfMap map[string]interface{}, key string) (map[string]interface{}, bool) {
+	if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{}), true
+	}
+
+	return nil, false
+}
+
+func expandNextActivity(tfMap map[string]interface{}) *string {
+	if v, ok := tfMap["next"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenPipelineActivities(apiObjects []*iotanalytics.PipelineActivity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AddAttributes; v != nil {
+			tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueMap(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Channel; v != nil {
+			tfMap["channel"] = []interface{}{map[string]interface{}{
+				"channel_name": aws.StringValue(v.ChannelName),
+				"name":         aws.StringValue(v.Name),
+				"next":         aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Datastore; v != nil {
+			tfMap["datastore"] = []interface{}{map[string]interface{}{
+				"datastore_name": aws.StringValue(v.DatastoreName),
+				"name":           aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.DeviceRegistryEnrich; v != nil {
+			tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.DeviceShadowEnrich; v != nil {
+			tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = []interface{}{map[string]interface{}{
+				"filter": aws.StringValue(v.Filter),
+				"name":   aws.StringValue(v.Name),
+				"next":   aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{map[string]interface{}{
+				"batch_size":  aws.Int64Value(v.BatchSize),
+				"lambda_name": aws.StringValue(v.LambdaName),
+				"name":        aws.StringValue(v.Name),
+				"next":        aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.Math; v != nil {
+			tfMap["math"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Attribute),
+				"math":      aws.StringValue(v.Math),
+				"name":      aws.StringValue(v.Name),
+				"next":      aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.RemoveAttributes; v != nil {
+			tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := apiObject.SelectAttributes; v != nil {
+			tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
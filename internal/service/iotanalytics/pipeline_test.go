@@ -0,0 +1,370 @@
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "channel"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "datastore"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_datastore.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "datastore"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(`pipeline/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourcePipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_activities(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotanalytics.Pipeline
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotanalytics_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_activities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "filter"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 0"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.attribute", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.math", "temperature * 1.8 + 32"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.device_id", "device"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.0", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "activity.5.datastore.0.name", "datastore"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_pipeline" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Pipeline %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPipelineExists(ctx context.Context, n string, v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn()
+
+		output, err := tfiotanalytics.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPipelineConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPipelineConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccPipelineConfig_activities(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "filter"
+    }
+  }
+
+  activity {
+    filter {
+      name   = "filter"
+      filter = "temperature > 0"
+      next   = "math"
+    }
+  }
+
+  activity {
+    math {
+      name      = "math"
+      attribute = "temperature_f"
+      math      = "temperature * 1.8 + 32"
+      next      = "add_attributes"
+    }
+  }
+
+  activity {
+    add_attributes {
+      name = "add_attributes"
+      next = "remove_attributes"
+
+      attributes = {
+        device_id = "device"
+      }
+    }
+  }
+
+  activity {
+    remove_attributes {
+      name       = "remove_attributes"
+      attributes = ["device_id"]
+      next       = "datastore"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
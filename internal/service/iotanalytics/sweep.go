@@ -0,0 +1,217 @@
+//go:build sweep
+// +build sweep
+
+package iotanalytics
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_iotanalytics_channel", &resource.Sweeper{
+		Name: "aws_iotanalytics_channel",
+		F:    sweepChannels,
+		Dependencies: []string{
+			"aws_iotanalytics_pipeline",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_iotanalytics_dataset", &resource.Sweeper{
+		Name: "aws_iotanalytics_dataset",
+		F:    sweepDatasets,
+	})
+
+	sweep.AddTestSweepers("aws_iotanalytics_datastore", &resource.Sweeper{
+		Name: "aws_iotanalytics_datastore",
+		F:    sweepDatastores,
+		Dependencies: []string{
+			"aws_iotanalytics_dataset",
+			"aws_iotanalytics_pipeline",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_iotanalytics_pipeline", &resource.Sweeper{
+		Name: "aws_iotanalytics_pipeline",
+		F:    sweepPipelines,
+	})
+}
+
+func sweepChannels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn()
+	input := &iotanalytics.ListChannelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListChannelsPagesWithContext(ctx, input, func(page *iotanalytics.ListChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ChannelSummaries {
+			r := ResourceChannel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChannelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Channel sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Channels (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Channels (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDatasets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn()
+	input := &iotanalytics.ListDatasetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListDatasetsPagesWithContext(ctx, input, func(page *iotanalytics.ListDatasetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatasetSummaries {
+			r := ResourceDataset()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatasetName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Dataset sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Datasets (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Datasets (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDatastores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn()
+	input := &iotanalytics.ListDatastoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListDatastoresPagesWithContext(ctx, input, func(page *iotanalytics.ListDatastoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatastoreSummaries {
+			r := ResourceDatastore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatastoreName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Datastore sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Datastores (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Datastores (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepPipelines(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn()
+	input := &iotanalytics.ListPipelinesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListPipelinesPagesWithContext(ctx, input, func(page *iotanalytics.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PipelineSummaries {
+			r := ResourcePipeline()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PipelineName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Pipeline sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Pipelines (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Pipelines (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,532 @@
+package iotevents
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_alarm_model", name="Alarm Model")
+// @Tags(identifierAttribute="arn")
+func ResourceAlarmModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAlarmModelCreate,
+		ReadWithoutTimeout:   resourceAlarmModelRead,
+		UpdateWithoutTimeout: resourceAlarmModelUpdate,
+		DeleteWithoutTimeout: resourceAlarmModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alarm_capabilities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acknowledge_flow": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"initialization_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_on_initialization": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alarm_event_actions": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validJSONDocument(func() interface{} { return &iotevents.AlarmEventActions{} }),
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+			"alarm_notification": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validJSONDocument(func() interface{} { return &iotevents.AlarmNotification{} }),
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+			"alarm_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison_operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(iotevents.ComparisonOperator_Values(), false),
+									},
+									"input_property": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"threshold": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateModelName,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlarmModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateAlarmModelInput{
+		AlarmModelName: aws.String(name),
+		AlarmRule:      expandAlarmRule(d.Get("alarm_rule").([]interface{})[0].(map[string]interface{})),
+		RoleArn:        aws.String(d.Get("role_arn").(string)),
+		Tags:           GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("alarm_capabilities"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_event_actions"); ok {
+		apiObject := &iotevents.AlarmEventActions{}
+
+		if err := jsonStringToDocument(v.(string), apiObject); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.AlarmEventActions = apiObject
+	}
+
+	if v, ok := d.GetOk("alarm_notification"); ok {
+		apiObject := &iotevents.AlarmNotification{}
+
+		if err := jsonStringToDocument(v.(string), apiObject); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.AlarmNotification = apiObject
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.AlarmModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.Int64(int64(v.(int)))
+	}
+
+	_, err := conn.CreateAlarmModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Alarm Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	output, err := FindAlarmModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Alarm Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if output.AlarmCapabilities != nil {
+		if err := d.Set("alarm_capabilities", []interface{}{flattenAlarmCapabilities(output.AlarmCapabilities)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting alarm_capabilities: %s", err)
+		}
+	} else {
+		d.Set("alarm_capabilities", nil)
+	}
+	if output.AlarmEventActions != nil {
+		v, err := jsonDocumentToString(d.Get("alarm_event_actions").(string), output.AlarmEventActions)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		d.Set("alarm_event_actions", v)
+	} else {
+		d.Set("alarm_event_actions", nil)
+	}
+	if output.AlarmNotification != nil {
+		v, err := jsonDocumentToString(d.Get("alarm_notification").(string), output.AlarmNotification)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		d.Set("alarm_notification", v)
+	} else {
+		d.Set("alarm_notification", nil)
+	}
+	if output.AlarmRule != nil {
+		if err := d.Set("alarm_rule", []interface{}{flattenAlarmRule(output.AlarmRule)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting alarm_rule: %s", err)
+		}
+	} else {
+		d.Set("alarm_rule", nil)
+	}
+	d.Set("arn", output.AlarmModelArn)
+	d.Set("description", output.AlarmModelDescription)
+	d.Set("key", output.Key)
+	d.Set("name", output.AlarmModelName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("severity", output.Severity)
+	d.Set("version", output.AlarmModelVersion)
+
+	return diags
+}
+
+func resourceAlarmModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateAlarmModelInput{
+			AlarmModelDescription: aws.String(d.Get("description").(string)),
+			AlarmModelName:        aws.String(d.Id()),
+			AlarmRule:             expandAlarmRule(d.Get("alarm_rule").([]interface{})[0].(map[string]interface{})),
+			RoleArn:               aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("alarm_capabilities"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("alarm_event_actions"); ok {
+			apiObject := &iotevents.AlarmEventActions{}
+
+			if err := jsonStringToDocument(v.(string), apiObject); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.AlarmEventActions = apiObject
+		}
+
+		if v, ok := d.GetOk("alarm_notification"); ok {
+			apiObject := &iotevents.AlarmNotification{}
+
+			if err := jsonStringToDocument(v.(string), apiObject); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.AlarmNotification = apiObject
+		}
+
+		if v, ok := d.GetOk("severity"); ok {
+			input.Severity = aws.Int64(int64(v.(int)))
+		}
+
+		_, err := conn.UpdateAlarmModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	log.Printf("[INFO] Deleting IoT Events Alarm Model: %s", d.Id())
+	_, err := conn.DeleteAlarmModelWithContext(ctx, &iotevents.DeleteAlarmModelInput{
+		AlarmModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAlarmModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAlarmModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DescribeAlarmModelOutput, error) {
+	input := &iotevents.DescribeAlarmModelInput{
+		AlarmModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAlarmModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAlarmModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAlarmModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAlarmModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.AlarmModelVersionStatusActivating},
+		Target:  []string{iotevents.AlarmModelVersionStatusActive},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAlarmModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.AlarmModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlarmRule(tfMap map[string]interface{}) *iotevents.AlarmRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AlarmRule{}
+
+	if v, ok := tfMap["simple_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SimpleRule = &iotevents.SimpleRule{
+			ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+			InputProperty:      aws.String(tfMap["input_property"].(string)),
+			Threshold:          aws.String(tfMap["threshold"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAlarmCapabilities(tfMap map[string]interface{}) *iotevents.AlarmCapabilities {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AlarmCapabilities{}
+
+	if v, ok := tfMap["acknowledge_flow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AcknowledgeFlow = &iotevents.AcknowledgeFlow{
+			Enabled: aws.Bool(v[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["initialization_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InitializationConfiguration = &iotevents.InitializationConfiguration{
+			DisabledOnInitialization: aws.Bool(v[0].(map[string]interface{})["disabled_on_initialization"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAlarmRule(apiObject *iotevents.AlarmRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SimpleRule; v != nil {
+		tfMap["simple_rule"] = []interface{}{map[string]interface{}{
+			"comparison_operator": aws.StringValue(v.ComparisonOperator),
+			"input_property":      aws.StringValue(v.InputProperty),
+			"threshold":           aws.StringValue(v.Threshold),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenAlarmCapabilities(apiObject *iotevents.AlarmCapabilities) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcknowledgeFlow; v != nil {
+		tfMap["acknowledge_flow"] = []interface{}{map[string]interface{}{
+			"enabled": aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.InitializationConfiguration; v != nil {
+		tfMap["initialization_configuration"] = []interface{}{map[string]interface{}{
+			"disabled_on_initialization": aws.BoolValue(v.DisabledOnInitialization),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,381 @@
+package iotevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_detector_model", name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func ResourceDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validJSONDocument(func() interface{} { return &iotevents.DetectorModelDefinition{} }),
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateModelName,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateModelName validates the names of detector models and alarm models.
+var validateModelName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+)
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	definition, err := expandDetectorModelDefinition(d.Get("definition").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: definition,
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		Tags:                    GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateDetectorModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	output, err := FindDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	definition, err := jsonDocumentToString(d.Get("definition").(string), output.DetectorModelDefinition)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	d.Set("arn", configuration.DetectorModelArn)
+	d.Set("definition", definition)
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	return diags
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		definition, err := expandDetectorModelDefinition(d.Get("definition").(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  definition,
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateDetectorModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	log.Printf("[INFO] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModelWithContext(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDetectorModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDetectorModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDetectorModelDefinition(s string) (*iotevents.DetectorModelDefinition, error) {
+	apiObject := &iotevents.DetectorModelDefinition{}
+
+	if err := jsonStringToDocument(s, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+// jsonStringToDocument decodes the JSON encoding of an IoT Events API object.
+// Unknown fields are an error rather than being silently ignored.
+func jsonStringToDocument(s string, apiObject interface{}) error {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(apiObject); err != nil {
+		return err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data after JSON document")
+	}
+
+	return nil
+}
+
+// validJSONDocument returns a SchemaValidateFunc which tests if the provided value
+// is the JSON encoding of the IoT Events API object returned by newAPIObject.
+func validJSONDocument(newAPIObject func() interface{}) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errs []error) {
+		s, ok := v.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if err := jsonStringToDocument(s, newAPIObject()); err != nil {
+			errs = append(errs, fmt.Errorf("%q contains an invalid JSON document: %w", k, err))
+		}
+
+		return
+	}
+}
+
+// jsonDocumentToString returns the JSON encoding of an IoT Events API object.
+// The existing document is returned if it is equivalent, to avoid spurious differences in formatting.
+func jsonDocumentToString(existing string, apiObject interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	if verify.JSONStringsEqual(existing, string(b)) {
+		return existing, nil
+	}
+
+	return string(b), nil
+}
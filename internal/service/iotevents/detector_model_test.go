@@ -0,0 +1,392 @@
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotevents", regexp.MustCompile(`detectorModel/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_unknownDefinitionField(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDetectorModelConfig_unknownDefinitionField(rName),
+				ExpectError: regexp.MustCompile(`unknown field "initialStateNmae"`),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "SERIAL"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DetectorModel
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_iotevents_detector_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotevents.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorModelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_detector_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorModelExists(ctx context.Context, n string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn()
+
+		output, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "sensorId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDetectorModelConfig_definition() string {
+	return `
+  definition = jsonencode({
+    initialStateName = "Normal"
+    states = [{
+      stateName = "Normal"
+      onInput = {
+        transitionEvents = [{
+          eventName = "TooHot"
+          condition = "$input.${aws_iotevents_input.test.name}.temperature > 80"
+          nextState = "Overheated"
+        }]
+      }
+      }, {
+      stateName = "Overheated"
+      onInput = {
+        transitionEvents = [{
+          eventName = "CooledDown"
+          condition = "$input.${aws_iotevents_input.test.name}.temperature <= 80"
+          nextState = "Normal"
+        }]
+      }
+    }]
+  })
+`
+}
+
+func testAccDetectorModelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+}
+`, rName, testAccDetectorModelConfig_definition()))
+}
+
+func testAccDetectorModelConfig_unknownDefinitionField(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition = jsonencode({
+    initialStateNmae = "Normal"
+    states = [{
+      stateName = "Normal"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name              = %[1]q
+  description       = "Updated"
+  evaluation_method = "SERIAL"
+  role_arn          = aws_iam_role.test.arn
+
+  definition = jsonencode({
+    initialStateName = "Normal"
+    states = [{
+      stateName = "Normal"
+      onEnter = {
+        events = [{
+          eventName = "Init"
+          actions = [{
+            setVariable = {
+              variableName = "count"
+              value        = "0"
+            }
+          }]
+        }]
+      }
+      onInput = {
+        transitionEvents = [{
+          eventName = "TooHot"
+          condition = "$input.${aws_iotevents_input.test.name}.temperature > 90"
+          nextState = "Overheated"
+        }]
+      }
+      }, {
+      stateName = "Overheated"
+      onInput = {
+        transitionEvents = [{
+          eventName = "CooledDown"
+          condition = "$input.${aws_iotevents_input.test.name}.temperature <= 90"
+          nextState = "Normal"
+        }]
+      }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccDetectorModelConfig_definition(), tagKey1, tagValue1))
+}
+
+func testAccDetectorModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccDetectorModelConfig_definition(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,319 @@
+package iotevents
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_input", name="Input")
+// @Tags(identifierAttribute="arn")
+func ResourceInput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputCreate,
+		ReadWithoutTimeout:   resourceInputRead,
+		UpdateWithoutTimeout: resourceInputUpdate,
+		DeleteWithoutTimeout: resourceInputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"input_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 200,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"json_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must start with a letter and contain only alphanumeric characters and underscores"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateInputInput{
+		InputDefinition: expandInputDefinition(d.Get("input_definition").([]interface{})[0].(map[string]interface{})),
+		InputName:       aws.String(name),
+		Tags:            GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.InputDescription = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateInputWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Input (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitInputActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Input (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInputRead(ctx, d, meta)...)
+}
+
+func resourceInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	output, err := FindInputByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.InputConfiguration
+	d.Set("arn", configuration.InputArn)
+	d.Set("description", configuration.InputDescription)
+	if output.InputDefinition != nil {
+		if err := d.Set("input_definition", []interface{}{flattenInputDefinition(output.InputDefinition)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting input_definition: %s", err)
+		}
+	} else {
+		d.Set("input_definition", nil)
+	}
+	d.Set("name", configuration.InputName)
+
+	return diags
+}
+
+func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateInputInput{
+			InputDefinition:  expandInputDefinition(d.Get("input_definition").([]interface{})[0].(map[string]interface{})),
+			InputDescription: aws.String(d.Get("description").(string)),
+			InputName:        aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateInputWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Input (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInputActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Input (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInputRead(ctx, d, meta)...)
+}
+
+func resourceInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn()
+
+	log.Printf("[INFO] Deleting IoT Events Input: %s", d.Id())
+	_, err := conn.DeleteInputWithContext(ctx, &iotevents.DeleteInputInput{
+		InputName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInputDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Input (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindInputByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	input := &iotevents.DescribeInputInput{
+		InputName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInputWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Input == nil || output.Input.InputConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Input, nil
+}
+
+func statusInput(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInputByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.InputConfiguration.Status), nil
+	}
+}
+
+func waitInputActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.Input, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.InputStatusCreating, iotevents.InputStatusUpdating},
+		Target:  []string{iotevents.InputStatusActive},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.Input, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.InputStatusDeleting, iotevents.InputStatusActive},
+		Target:  []string{},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandInputDefinition(tfMap map[string]interface{}) *iotevents.InputDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.InputDefinition{}
+
+	for _, v := range tfMap["attribute"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		apiObject.Attributes = append(apiObject.Attributes, &iotevents.Attribute{
+			JsonPath: aws.String(v.(map[string]interface{})["json_path"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenInputDefinition(apiObject *iotevents.InputDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var attributes []interface{}
+
+	for _, v := range apiObject.Attributes {
+		if v == nil {
+			continue
+		}
+
+		attributes = append(attributes, map[string]interface{}{
+			"json_path": aws.StringValue(v.JsonPath),
+		})
+	}
+
+	return map[string]interface{}{
+		"attribute": attributes,
+	}
+}
@@ -0,0 +1,242 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lambda_runtime_management_config")
+func ResourceRuntimeManagementConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuntimeManagementConfigCreate,
+		ReadWithoutTimeout:   resourceRuntimeManagementConfigRead,
+		UpdateWithoutTimeout: resourceRuntimeManagementConfigUpdate,
+		DeleteWithoutTimeout: resourceRuntimeManagementConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"runtime_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"update_runtime_on": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.UpdateRuntimeOnAuto,
+				ValidateFunc: validation.StringInSlice(lambda.UpdateRuntimeOn_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRuntimeManagementConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	name := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := RuntimeManagementConfigCreateResourceID(name, qualifier)
+	input := expandPutRuntimeManagementConfigInput(d, name, qualifier)
+
+	_, err := conn.PutRuntimeManagementConfigWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Lambda Runtime Management Config (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceRuntimeManagementConfigRead(ctx, d, meta)...)
+}
+
+func resourceRuntimeManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Runtime Management Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", name)
+	d.Set("qualifier", qualifier)
+	d.Set("runtime_version_arn", output.RuntimeVersionArn)
+	d.Set("update_runtime_on", output.UpdateRuntimeOn)
+
+	return diags
+}
+
+func resourceRuntimeManagementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := expandPutRuntimeManagementConfigInput(d, name, qualifier)
+
+	_, err = conn.PutRuntimeManagementConfigWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRuntimeManagementConfigRead(ctx, d, meta)...)
+}
+
+func resourceRuntimeManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	name, qualifier, err := RuntimeManagementConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// There is no API to delete a runtime management configuration.
+	// Reset it to the service default instead.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(name),
+		UpdateRuntimeOn: aws.String(lambda.UpdateRuntimeOnAuto),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[INFO] Deleting Lambda Runtime Management Config: %s", d.Id())
+	_, err = conn.PutRuntimeManagementConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindRuntimeManagementConfigByNameAndQualifier(ctx context.Context, conn *lambda.Lambda, name, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const runtimeManagementConfigResourceIDSeparator = ","
+
+func RuntimeManagementConfigCreateResourceID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	parts := []string{functionName, qualifier}
+	id := strings.Join(parts, runtimeManagementConfigResourceIDSeparator)
+
+	return id
+}
+
+func RuntimeManagementConfigParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, runtimeManagementConfigResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]qQUALIFIER or FUNCTION-NAME", id, runtimeManagementConfigResourceIDSeparator)
+}
+
+func expandPutRuntimeManagementConfigInput(d *schema.ResourceData, name, qualifier string) *lambda.PutRuntimeManagementConfigInput {
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(name),
+		UpdateRuntimeOn: aws.String(d.Get("update_runtime_on").(string)),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("runtime_version_arn"); ok {
+		input.RuntimeVersionArn = aws.String(v.(string))
+	}
+
+	return input
+}
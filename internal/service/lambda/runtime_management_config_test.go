@@ -0,0 +1,196 @@
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLambdaRuntimeManagementConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetRuntimeManagementConfigOutput
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_rmc_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_rmc_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_rmc_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(funcName, policyName, roleName, lambda.UpdateRuntimeOnFunctionUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "function_name", funcName),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", lambda.UpdateRuntimeOnFunctionUpdate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(funcName, policyName, roleName, lambda.UpdateRuntimeOnAuto),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", lambda.UpdateRuntimeOnAuto),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_qualifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetRuntimeManagementConfigOutput
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_rmc_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_rmc_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_rmc_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_qualifier(funcName, policyName, roleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", functionResourceName, "qualified_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", functionResourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", lambda.UpdateRuntimeOnFunctionUpdate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeManagementConfigExists(ctx context.Context, n string, v *lambda.GetRuntimeManagementConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Runtime Management Config ID is set")
+		}
+
+		name, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
+
+		output, err := tflambda.FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// The runtime management configuration cannot be deleted, only reset to the default.
+func testAccCheckRuntimeManagementConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_runtime_management_config" {
+				continue
+			}
+
+			name, qualifier, err := tflambda.RuntimeManagementConfigParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			output, err := tflambda.FindRuntimeManagementConfigByNameAndQualifier(ctx, conn, name, qualifier)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if v := aws.StringValue(output.UpdateRuntimeOn); v != lambda.UpdateRuntimeOnAuto {
+				return fmt.Errorf("Lambda Runtime Management Config %s still exists with update_runtime_on %s", rs.Primary.ID, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRuntimeManagementConfigConfig_basic(funcName, policyName, roleName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs18.x"
+}
+
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = %[2]q
+}
+`, funcName, updateRuntimeOn))
+}
+
+func testAccRuntimeManagementConfigConfig_qualifier(funcName, policyName, roleName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs18.x"
+  publish       = true
+}
+
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  qualifier         = aws_lambda_function.test.version
+  update_runtime_on = "FunctionUpdate"
+}
+`, funcName))
+}
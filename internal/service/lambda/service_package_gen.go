@@ -98,6 +98,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceProvisionedConcurrencyConfig,
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 		},
+		{
+			Factory:  ResourceRuntimeManagementConfig,
+			TypeName: "aws_lambda_runtime_management_config",
+		},
 	}
 }
 
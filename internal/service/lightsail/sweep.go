@@ -16,17 +16,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
@@ -113,7 +113,11 @@ func sweepInstances(region string) error {
 			}
 
 			log.Printf("[INFO] Deleting Lightsail Instance: %s", name)
-			_, err := conn.DeleteInstanceWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_lightsail_instance", name, func() error {
+				_, err := conn.DeleteInstanceWithContext(ctx, input)
+
+				return err
+			})
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Lightsail Instance (%s): %s", name, err)
@@ -161,8 +165,12 @@ func sweepStaticIPs(region string) error {
 			name := aws.StringValue(staticIp.Name)
 
 			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
-			_, err := conn.ReleaseStaticIpWithContext(ctx, &lightsail.ReleaseStaticIpInput{
-				StaticIpName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_lightsail_static_ip", name, func() error {
+				_, err := conn.ReleaseStaticIpWithContext(ctx, &lightsail.ReleaseStaticIpInput{
+					StaticIpName: aws.String(name),
+				})
+
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err)
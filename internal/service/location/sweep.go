@@ -16,32 +16,32 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
 		Name: "aws_location_geofence_collection",
 		F:    sweepGeofenceCollections,
 	})
 
-	resource.AddTestSweepers("aws_location_map", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_map", &resource.Sweeper{
 		Name: "aws_location_map",
 		F:    sweepMaps,
 	})
 
-	resource.AddTestSweepers("aws_location_place_index", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_place_index", &resource.Sweeper{
 		Name: "aws_location_place_index",
 		F:    sweepPlaceIndexes,
 	})
 
-	resource.AddTestSweepers("aws_location_route_calculator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_route_calculator", &resource.Sweeper{
 		Name: "aws_location_route_calculator",
 		F:    sweepRouteCalculators,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_tracker", &resource.Sweeper{
 		Name: "aws_location_tracker",
 		F:    sweepTrackers,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_tracker_association", &resource.Sweeper{
 		Name: "aws_location_tracker_association",
 		F:    sweepTrackerAssociations,
 	})
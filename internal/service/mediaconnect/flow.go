@@ -0,0 +1,2064 @@
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="arn")
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowCreate,
+		ReadWithoutTimeout:   resourceFlowRead,
+		UpdateWithoutTimeout: resourceFlowUpdate,
+		DeleteWithoutTimeout: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_transfer_subscriber_fee_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": encryptionSchema(),
+						"entitlement_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.EntitlementStatus_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"media_stream": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fmtp": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"channel_order": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"colorimetry": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.Colorimetry_Values(), false),
+												},
+												"exact_framerate": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"par": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"range": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.Range_Values(), false),
+												},
+												"scan_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.ScanMode_Values(), false),
+												},
+												"tcs": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.Tcs_Values(), false),
+												},
+											},
+										},
+									},
+									"lang": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"clock_rate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"fmt": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"media_stream_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"media_stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_stream_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.MediaStreamType_Values(), false),
+						},
+						"video_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_allow_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidCIDRNetworkAddress,
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": encryptionSchema(),
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"media_stream_output_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_ip": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"destination_port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+												"interface_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"outbound_ip": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"encoding_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(mediaconnect.EncodingName_Values(), false),
+									},
+									"encoding_parameters": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compression_factor": {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+												"encoder_profile": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mediaconnect.EncoderProfile_Values(), false),
+												},
+											},
+										},
+									},
+									"media_stream_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sender_control_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"smoothing_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decryption": encryptionSchema(),
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"entitlement_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ingest_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingest_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_sync_buffer": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"media_stream_source_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encoding_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(mediaconnect.EncodingName_Values(), false),
+									},
+									"input_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_ip": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"input_port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+												"interface_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"media_stream_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"sender_control_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"sender_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"whitelist_cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"source_failover_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.FailoverMode_Values(), false),
+						},
+						"recovery_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"source_priority": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"primary_source": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.State_Values(), false),
+						},
+					},
+				},
+			},
+			"start_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.NetworkInterfaceType_Values(), false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func encryptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.Algorithm_Values(), false),
+				},
+				"constant_initialization_vector": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"device_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.KeyType_Values(), false),
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"resource_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn()
+
+	name := d.Get("name").(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name:    aws.String(name),
+		Sources: expandSetSourceRequests(d.Get("source").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		input.Entitlements = expandGrantEntitlementRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("media_stream"); ok && len(v.([]interface{})) > 0 {
+		input.MediaStreams = expandAddMediaStreamRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandAddOutputRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_interface"); ok && len(v.([]interface{})) > 0 {
+		input.VpcInterfaces = expandVPCInterfaceRequests(v.([]interface{}))
+	}
+
+	output, err := conn.CreateFlowWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if _, err := waitFlowStandby(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) create: %s", d.Id(), err)
+	}
+
+	// CreateFlow does not accept tags.
+	if tags := KeyValueTags(ctx, GetTagsIn(ctx)); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, d.Id(), nil, tags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adding MediaConnect Flow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("start_flow").(bool) {
+		if err := startFlow(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceFlowRead(ctx, d, meta)...)
+}
+
+func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn()
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+	if err := d.Set("entitlement", orderByName(flattenEntitlements(flow.Entitlements), d.Get("entitlement").([]interface{}), "name")); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entitlement: %s", err)
+	}
+	if err := d.Set("media_stream", orderByName(flattenMediaStreams(flow.MediaStreams), d.Get("media_stream").([]interface{}), "media_stream_name")); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting media_stream: %s", err)
+	}
+	d.Set("name", flow.Name)
+	if err := d.Set("output", orderByName(flattenOutputs(flow.Outputs), d.Get("output").([]interface{}), "name")); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []*mediaconnect.Source{flow.Source}
+	}
+	if err := d.Set("source", orderByName(flattenSources(sources), d.Get("source").([]interface{}), "name")); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	if flow.SourceFailoverConfig != nil {
+		if err := d.Set("source_failover_config", []interface{}{flattenFailoverConfig(flow.SourceFailoverConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting source_failover_config: %s", err)
+		}
+	} else {
+		d.Set("source_failover_config", nil)
+	}
+	d.Set("status", flow.Status)
+	if err := d.Set("vpc_interface", orderByName(flattenVPCInterfaces(flow.VpcInterfaces), d.Get("vpc_interface").([]interface{}), "name")); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_interface: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn()
+
+	if d.HasChangesExcept("tags", "tags_all", "start_flow") {
+		flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		// Most changes can only be made while the flow is stopped.
+		if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+			if err := stopFlow(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		if err := updateFlowComponents(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		if d.HasChange("source_failover_config") {
+			input := &mediaconnect.UpdateFlowInput{
+				FlowArn: aws.String(d.Id()),
+			}
+
+			if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SourceFailoverConfig = expandUpdateFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+
+			_, err := conn.UpdateFlowWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitFlowStandby(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	switch status := aws.StringValue(flow.Status); {
+	case d.Get("start_flow").(bool) && status == mediaconnect.StatusStandby:
+		if err := startFlow(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	case !d.Get("start_flow").(bool) && status == mediaconnect.StatusActive:
+		if err := stopFlow(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceFlowRead(ctx, d, meta)...)
+}
+
+func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectConn()
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if status := aws.StringValue(flow.Status); status == mediaconnect.StatusActive || status == mediaconnect.StatusStarting {
+		if err := stopFlow(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting MediaConnect Flow: %s", d.Id())
+	_, err = conn.DeleteFlowWithContext(ctx, &mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateFlowComponents reconciles the flow's media streams, VPC interfaces, sources,
+// outputs and entitlements. Components are matched by name; new components are added
+// before the components that may reference them, and removed in the reverse order.
+func updateFlowComponents(ctx context.Context, conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	arn := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	waitUpdated := func() error {
+		if _, err := waitFlowStandby(ctx, conn, arn, timeout); err != nil {
+			return fmt.Errorf("waiting for update: %w", err)
+		}
+
+		return nil
+	}
+
+	o, n := d.GetChange("output")
+	removedOutputs, addedOutputs, updatedOutputs := diffByName(o.([]interface{}), n.([]interface{}), "name")
+
+	for _, tfMap := range removedOutputs {
+		if _, err := conn.RemoveFlowOutputWithContext(ctx, &mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(arn),
+			OutputArn: aws.String(tfMap["arn"].(string)),
+		}); err != nil {
+			return fmt.Errorf("removing output (%s): %w", tfMap["name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	o, n = d.GetChange("entitlement")
+	removedEntitlements, addedEntitlements, updatedEntitlements := diffByName(o.([]interface{}), n.([]interface{}), "name")
+
+	// The data transfer fee can't be updated, so the entitlement is revoked and granted again.
+	oldEntitlementFees := make(map[string]interface{})
+	for _, v := range o.([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			oldEntitlementFees[tfMap["name"].(string)] = tfMap["data_transfer_subscriber_fee_percent"]
+		}
+	}
+
+	var modifiedEntitlements []map[string]interface{}
+	for _, tfMap := range updatedEntitlements {
+		if oldEntitlementFees[tfMap["name"].(string)] != tfMap["data_transfer_subscriber_fee_percent"] {
+			removedEntitlements = append(removedEntitlements, tfMap)
+			addedEntitlements = append(addedEntitlements, tfMap)
+		} else {
+			modifiedEntitlements = append(modifiedEntitlements, tfMap)
+		}
+	}
+	updatedEntitlements = modifiedEntitlements
+
+	for _, tfMap := range removedEntitlements {
+		if _, err := conn.RevokeFlowEntitlementWithContext(ctx, &mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: aws.String(tfMap["arn"].(string)),
+			FlowArn:        aws.String(arn),
+		}); err != nil {
+			return fmt.Errorf("revoking entitlement (%s): %w", tfMap["name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	o, n = d.GetChange("source")
+	removedSources, addedSources, updatedSources := diffByName(o.([]interface{}), n.([]interface{}), "name")
+
+	// A flow must always have a source, so new sources are added before old ones are removed.
+	if len(addedSources) > 0 {
+		if _, err := conn.AddFlowSourcesWithContext(ctx, &mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(arn),
+			Sources: expandSetSourceRequests(mapsToInterfaces(addedSources)),
+		}); err != nil {
+			return fmt.Errorf("adding sources: %w", err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range removedSources {
+		if _, err := conn.RemoveFlowSourceWithContext(ctx, &mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(arn),
+			SourceArn: aws.String(tfMap["arn"].(string)),
+		}); err != nil {
+			return fmt.Errorf("removing source (%s): %w", tfMap["name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	o, n = d.GetChange("media_stream")
+	removedMediaStreams, addedMediaStreams, updatedMediaStreams := diffByName(o.([]interface{}), n.([]interface{}), "media_stream_name")
+
+	for _, tfMap := range removedMediaStreams {
+		if _, err := conn.RemoveFlowMediaStreamWithContext(ctx, &mediaconnect.RemoveFlowMediaStreamInput{
+			FlowArn:         aws.String(arn),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		}); err != nil {
+			return fmt.Errorf("removing media stream (%s): %w", tfMap["media_stream_name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	o, n = d.GetChange("vpc_interface")
+	removedVPCInterfaces, addedVPCInterfaces, updatedVPCInterfaces := diffByName(o.([]interface{}), n.([]interface{}), "name")
+
+	// VPC interfaces can't be modified in place.
+	for _, tfMap := range append(removedVPCInterfaces, updatedVPCInterfaces...) {
+		if _, err := conn.RemoveFlowVpcInterfaceWithContext(ctx, &mediaconnect.RemoveFlowVpcInterfaceInput{
+			FlowArn:          aws.String(arn),
+			VpcInterfaceName: aws.String(tfMap["name"].(string)),
+		}); err != nil {
+			return fmt.Errorf("removing VPC interface (%s): %w", tfMap["name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	if v := append(addedVPCInterfaces, updatedVPCInterfaces...); len(v) > 0 {
+		if _, err := conn.AddFlowVpcInterfacesWithContext(ctx, &mediaconnect.AddFlowVpcInterfacesInput{
+			FlowArn:       aws.String(arn),
+			VpcInterfaces: expandVPCInterfaceRequests(mapsToInterfaces(v)),
+		}); err != nil {
+			return fmt.Errorf("adding VPC interfaces: %w", err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	if len(addedMediaStreams) > 0 {
+		if _, err := conn.AddFlowMediaStreamsWithContext(ctx, &mediaconnect.AddFlowMediaStreamsInput{
+			FlowArn:      aws.String(arn),
+			MediaStreams: expandAddMediaStreamRequests(mapsToInterfaces(addedMediaStreams)),
+		}); err != nil {
+			return fmt.Errorf("adding media streams: %w", err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range updatedMediaStreams {
+		input := &mediaconnect.UpdateFlowMediaStreamInput{
+			FlowArn:         aws.String(arn),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+			MediaStreamType: aws.String(tfMap["media_stream_type"].(string)),
+		}
+		mediaStream := expandAddMediaStreamRequest(tfMap)
+		input.Attributes = mediaStream.Attributes
+		input.ClockRate = mediaStream.ClockRate
+		input.Description = mediaStream.Description
+		input.VideoFormat = mediaStream.VideoFormat
+
+		if _, err := conn.UpdateFlowMediaStreamWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating media stream (%s): %w", tfMap["media_stream_name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range updatedSources {
+		source := expandSetSourceRequest(tfMap)
+		input := &mediaconnect.UpdateFlowSourceInput{
+			Decryption:                      expandUpdateEncryption(tfMap["decryption"].([]interface{})),
+			Description:                     source.Description,
+			EntitlementArn:                  source.EntitlementArn,
+			FlowArn:                         aws.String(arn),
+			IngestPort:                      source.IngestPort,
+			MaxBitrate:                      source.MaxBitrate,
+			MaxLatency:                      source.MaxLatency,
+			MaxSyncBuffer:                   source.MaxSyncBuffer,
+			MediaStreamSourceConfigurations: source.MediaStreamSourceConfigurations,
+			MinLatency:                      source.MinLatency,
+			Protocol:                        source.Protocol,
+			SenderControlPort:               source.SenderControlPort,
+			SenderIpAddress:                 source.SenderIpAddress,
+			SourceArn:                       aws.String(tfMap["arn"].(string)),
+			StreamId:                        source.StreamId,
+			VpcInterfaceName:                source.VpcInterfaceName,
+			WhitelistCidr:                   source.WhitelistCidr,
+		}
+
+		if _, err := conn.UpdateFlowSourceWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating source (%s): %w", tfMap["name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	if len(addedOutputs) > 0 {
+		if _, err := conn.AddFlowOutputsWithContext(ctx, &mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(arn),
+			Outputs: expandAddOutputRequests(mapsToInterfaces(addedOutputs)),
+		}); err != nil {
+			return fmt.Errorf("adding outputs: %w", err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range updatedOutputs {
+		output := expandAddOutputRequest(tfMap)
+		input := &mediaconnect.UpdateFlowOutputInput{
+			CidrAllowList:                   output.CidrAllowList,
+			Description:                     output.Description,
+			Destination:                     output.Destination,
+			Encryption:                      expandUpdateEncryption(tfMap["encryption"].([]interface{})),
+			FlowArn:                         aws.String(arn),
+			MaxLatency:                      output.MaxLatency,
+			MediaStreamOutputConfigurations: output.MediaStreamOutputConfigurations,
+			MinLatency:                      output.MinLatency,
+			OutputArn:                       aws.String(tfMap["arn"].(string)),
+			Port:                            output.Port,
+			Protocol:                        output.Protocol,
+			RemoteId:                        output.RemoteId,
+			SenderControlPort:               output.SenderControlPort,
+			SmoothingLatency:                output.SmoothingLatency,
+			StreamId:                        output.StreamId,
+			VpcInterfaceAttachment:          output.VpcInterfaceAttachment,
+		}
+
+		if _, err := conn.UpdateFlowOutputWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating output (%s): %w", tfMap["name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	if len(addedEntitlements) > 0 {
+		if _, err := conn.GrantFlowEntitlementsWithContext(ctx, &mediaconnect.GrantFlowEntitlementsInput{
+			Entitlements: expandGrantEntitlementRequests(mapsToInterfaces(addedEntitlements)),
+			FlowArn:      aws.String(arn),
+		}); err != nil {
+			return fmt.Errorf("granting entitlements: %w", err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	for _, tfMap := range updatedEntitlements {
+		entitlement := expandGrantEntitlementRequest(tfMap)
+		input := &mediaconnect.UpdateFlowEntitlementInput{
+			Description:       entitlement.Description,
+			Encryption:        expandUpdateEncryption(tfMap["encryption"].([]interface{})),
+			EntitlementArn:    aws.String(tfMap["arn"].(string)),
+			EntitlementStatus: entitlement.EntitlementStatus,
+			FlowArn:           aws.String(arn),
+			Subscribers:       entitlement.Subscribers,
+		}
+
+		if _, err := conn.UpdateFlowEntitlementWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating entitlement (%s): %w", tfMap["name"].(string), err)
+		}
+
+		if err := waitUpdated(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffByName compares the old and new values of a list of named blocks.
+// Updated blocks are returned with the new configuration and the old block's ARN.
+func diffByName(o, n []interface{}, key string) (removed, added, updated []map[string]interface{}) {
+	oldByName := make(map[string]map[string]interface{})
+
+	for _, v := range o {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			oldByName[tfMap[key].(string)] = tfMap
+		}
+	}
+
+	newNames := make(map[string]struct{})
+
+	for _, v := range n {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap[key].(string)
+		newNames[name] = struct{}{}
+
+		old, ok := oldByName[name]
+
+		if !ok {
+			added = append(added, tfMap)
+			continue
+		}
+
+		if arn, ok := old["arn"]; ok {
+			tfMap["arn"] = arn
+		}
+
+		if !reflect.DeepEqual(old, tfMap) {
+			updated = append(updated, tfMap)
+		}
+	}
+
+	for name, tfMap := range oldByName {
+		if _, ok := newNames[name]; !ok {
+			removed = append(removed, tfMap)
+		}
+	}
+
+	return removed, added, updated
+}
+
+// orderByName orders the flattened blocks to match the order of the configured blocks.
+// Blocks that are not configured are appended in the order returned by the API.
+func orderByName(tfList, configured []interface{}, key string) []interface{} {
+	byName := make(map[string]interface{})
+
+	for _, v := range tfList {
+		byName[v.(map[string]interface{})[key].(string)] = v
+	}
+
+	ordered := make([]interface{}, 0, len(tfList))
+
+	for _, v := range configured {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap[key].(string)
+
+		if v, ok := byName[name]; ok {
+			ordered = append(ordered, v)
+			delete(byName, name)
+		}
+	}
+
+	for _, v := range tfList {
+		if _, ok := byName[v.(map[string]interface{})[key].(string)]; ok {
+			ordered = append(ordered, v)
+		}
+	}
+
+	return ordered
+}
+
+func mapsToInterfaces(tfMaps []map[string]interface{}) []interface{} {
+	tfList := make([]interface{}, 0, len(tfMaps))
+
+	for _, v := range tfMaps {
+		tfList = append(tfList, v)
+	}
+
+	return tfList
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) error {
+	_, err := conn.StartFlowWithContext(ctx, &mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowActive(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) start: %w", arn, err)
+	}
+
+	return nil
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) error {
+	_, err := conn.StopFlowWithContext(ctx, &mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStandby(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for MediaConnect Flow (%s) stop: %w", arn, err)
+	}
+
+	return nil
+}
+
+func FindFlowByARN(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitFlowStandby(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating, mediaconnect.StatusStopping},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowActive(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStandby, mediaconnect.StatusStarting, mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{mediaconnect.StatusDeleting, mediaconnect.StatusStandby},
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandEncryption(tfList []interface{}) *mediaconnect.Encryption {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &mediaconnect.Encryption{
+		RoleArn: aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["algorithm"].(string); ok && v != "" {
+		apiObject.Algorithm = aws.String(v)
+	}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_type"].(string); ok && v != "" {
+		apiObject.KeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateEncryption(tfList []interface{}) *mediaconnect.UpdateEncryption {
+	apiObject := expandEncryption(tfList)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateEncryption{
+		Algorithm:                    apiObject.Algorithm,
+		ConstantInitializationVector: apiObject.ConstantInitializationVector,
+		DeviceId:                     apiObject.DeviceId,
+		KeyType:                      apiObject.KeyType,
+		Region:                       apiObject.Region,
+		ResourceId:                   apiObject.ResourceId,
+		RoleArn:                      apiObject.RoleArn,
+		SecretArn:                    apiObject.SecretArn,
+		Url:                          apiObject.Url,
+	}
+}
+
+func flattenEncryption(apiObject *mediaconnect.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"algorithm":                      aws.StringValue(apiObject.Algorithm),
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"device_id":                      aws.StringValue(apiObject.DeviceId),
+		"key_type":                       aws.StringValue(apiObject.KeyType),
+		"region":                         aws.StringValue(apiObject.Region),
+		"resource_id":                    aws.StringValue(apiObject.ResourceId),
+		"role_arn":                       aws.StringValue(apiObject.RoleArn),
+		"secret_arn":                     aws.StringValue(apiObject.SecretArn),
+		"url":                            aws.StringValue(apiObject.Url),
+	}}
+}
+
+func expandSetSourceRequests(tfList []interface{}) []*mediaconnect.SetSourceRequest {
+	var apiObjects []*mediaconnect.SetSourceRequest
+
+	for _, v := range tfList {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObjects = append(apiObjects, expandSetSourceRequest(tfMap))
+		}
+	}
+
+	return apiObjects
+}
+
+func expandSetSourceRequest(tfMap map[string]interface{}) *mediaconnect.SetSourceRequest {
+	apiObject := &mediaconnect.SetSourceRequest{
+		Decryption: expandEncryption(tfMap["decryption"].([]interface{})),
+		Name:       aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_sync_buffer"].(int); ok && v != 0 {
+		apiObject.MaxSyncBuffer = aws.Int64(int64(v))
+	}
+
+	for _, v := range tfMap["media_stream_source_configuration"].([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		configuration := &mediaconnect.MediaStreamSourceConfigurationRequest{
+			EncodingName:    aws.String(tfMap["encoding_name"].(string)),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		}
+
+		for _, v := range tfMap["input_configuration"].([]interface{}) {
+			tfMap, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			configuration.InputConfigurations = append(configuration.InputConfigurations, &mediaconnect.InputConfigurationRequest{
+				InputPort: aws.Int64(int64(tfMap["input_port"].(int))),
+				Interface: &mediaconnect.InterfaceRequest{
+					Name: aws.String(tfMap["interface_name"].(string)),
+				},
+			})
+		}
+
+		apiObject.MediaStreamSourceConfigurations = append(apiObject.MediaStreamSourceConfigurations, configuration)
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["sender_ip_address"].(string); ok && v != "" {
+		apiObject.SenderIpAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSources(apiObjects []*mediaconnect.Source) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":                 aws.StringValue(apiObject.SourceArn),
+			"decryption":          flattenEncryption(apiObject.Decryption),
+			"description":         aws.StringValue(apiObject.Description),
+			"entitlement_arn":     aws.StringValue(apiObject.EntitlementArn),
+			"ingest_ip":           aws.StringValue(apiObject.IngestIp),
+			"ingest_port":         aws.Int64Value(apiObject.IngestPort),
+			"name":                aws.StringValue(apiObject.Name),
+			"sender_control_port": aws.Int64Value(apiObject.SenderControlPort),
+			"sender_ip_address":   aws.StringValue(apiObject.SenderIpAddress),
+			"vpc_interface_name":  aws.StringValue(apiObject.VpcInterfaceName),
+			"whitelist_cidr":      aws.StringValue(apiObject.WhitelistCidr),
+		}
+
+		var configurations []interface{}
+
+		for _, v := range apiObject.MediaStreamSourceConfigurations {
+			if v == nil {
+				continue
+			}
+
+			var inputConfigurations []interface{}
+
+			for _, v := range v.InputConfigurations {
+				if v == nil {
+					continue
+				}
+
+				inputConfiguration := map[string]interface{}{
+					"input_ip":   aws.StringValue(v.InputIp),
+					"input_port": aws.Int64Value(v.InputPort),
+				}
+
+				if v.Interface != nil {
+					inputConfiguration["interface_name"] = aws.StringValue(v.Interface.Name)
+				}
+
+				inputConfigurations = append(inputConfigurations, inputConfiguration)
+			}
+
+			configurations = append(configurations, map[string]interface{}{
+				"encoding_name":       aws.StringValue(v.EncodingName),
+				"input_configuration": inputConfigurations,
+				"media_stream_name":   aws.StringValue(v.MediaStreamName),
+			})
+		}
+
+		tfMap["media_stream_source_configuration"] = configurations
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["max_bitrate"] = aws.Int64Value(v.MaxBitrate)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["max_sync_buffer"] = aws.Int64Value(v.MaxSyncBuffer)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandAddOutputRequests(tfList []interface{}) []*mediaconnect.AddOutputRequest {
+	var apiObjects []*mediaconnect.AddOutputRequest
+
+	for _, v := range tfList {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObjects = append(apiObjects, expandAddOutputRequest(tfMap))
+		}
+	}
+
+	return apiObjects
+}
+
+func expandAddOutputRequest(tfMap map[string]interface{}) *mediaconnect.AddOutputRequest {
+	apiObject := &mediaconnect.AddOutputRequest{
+		Encryption: expandEncryption(tfMap["encryption"].([]interface{})),
+		Name:       aws.String(tfMap["name"].(string)),
+		Protocol:   aws.String(tfMap["protocol"].(string)),
+	}
+
+	if v, ok := tfMap["cidr_allow_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CidrAllowList = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	for _, v := range tfMap["media_stream_output_configuration"].([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		configuration := &mediaconnect.MediaStreamOutputConfigurationRequest{
+			EncodingName:    aws.String(tfMap["encoding_name"].(string)),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		}
+
+		for _, v := range tfMap["destination_configuration"].([]interface{}) {
+			tfMap, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			configuration.DestinationConfigurations = append(configuration.DestinationConfigurations, &mediaconnect.DestinationConfigurationRequest{
+				DestinationIp:   aws.String(tfMap["destination_ip"].(string)),
+				DestinationPort: aws.Int64(int64(tfMap["destination_port"].(int))),
+				Interface: &mediaconnect.InterfaceRequest{
+					Name: aws.String(tfMap["interface_name"].(string)),
+				},
+			})
+		}
+
+		if v, ok := tfMap["encoding_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			configuration.EncodingParameters = &mediaconnect.EncodingParametersRequest{
+				CompressionFactor: aws.Float64(tfMap["compression_factor"].(float64)),
+				EncoderProfile:    aws.String(tfMap["encoder_profile"].(string)),
+			}
+		}
+
+		apiObject.MediaStreamOutputConfigurations = append(apiObject.MediaStreamOutputConfigurations, configuration)
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceAttachment = &mediaconnect.VpcInterfaceAttachment{
+			VpcInterfaceName: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenOutputs(apiObjects []*mediaconnect.Output) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":         aws.StringValue(apiObject.OutputArn),
+			"description": aws.StringValue(apiObject.Description),
+			"destination": aws.StringValue(apiObject.Destination),
+			"encryption":  flattenEncryption(apiObject.Encryption),
+			"name":        aws.StringValue(apiObject.Name),
+			"port":        aws.Int64Value(apiObject.Port),
+		}
+
+		var configurations []interface{}
+
+		for _, v := range apiObject.MediaStreamOutputConfigurations {
+			if v == nil {
+				continue
+			}
+
+			var destinationConfigurations []interface{}
+
+			for _, v := range v.DestinationConfigurations {
+				if v == nil {
+					continue
+				}
+
+				destinationConfiguration := map[string]interface{}{
+					"destination_ip":   aws.StringValue(v.DestinationIp),
+					"destination_port": aws.Int64Value(v.DestinationPort),
+					"outbound_ip":      aws.StringValue(v.OutboundIp),
+				}
+
+				if v.Interface != nil {
+					destinationConfiguration["interface_name"] = aws.StringValue(v.Interface.Name)
+				}
+
+				destinationConfigurations = append(destinationConfigurations, destinationConfiguration)
+			}
+
+			configuration := map[string]interface{}{
+				"destination_configuration": destinationConfigurations,
+				"encoding_name":             aws.StringValue(v.EncodingName),
+				"media_stream_name":         aws.StringValue(v.MediaStreamName),
+			}
+
+			if v := v.EncodingParameters; v != nil {
+				configuration["encoding_parameters"] = []interface{}{map[string]interface{}{
+					"compression_factor": aws.Float64Value(v.CompressionFactor),
+					"encoder_profile":    aws.StringValue(v.EncoderProfile),
+				}}
+			}
+
+			configurations = append(configurations, configuration)
+		}
+
+		tfMap["media_stream_output_configuration"] = configurations
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["cidr_allow_list"] = aws.StringValueSlice(v.CidrAllowList)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["remote_id"] = aws.StringValue(v.RemoteId)
+			tfMap["sender_control_port"] = aws.Int64Value(v.SenderControlPort)
+			tfMap["smoothing_latency"] = aws.Int64Value(v.SmoothingLatency)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		if v := apiObject.VpcInterfaceAttachment; v != nil {
+			tfMap["vpc_interface_name"] = aws.StringValue(v.VpcInterfaceName)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandGrantEntitlementRequests(tfList []interface{}) []*mediaconnect.GrantEntitlementRequest {
+	var apiObjects []*mediaconnect.GrantEntitlementRequest
+
+	for _, v := range tfList {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObjects = append(apiObjects, expandGrantEntitlementRequest(tfMap))
+		}
+	}
+
+	return apiObjects
+}
+
+func expandGrantEntitlementRequest(tfMap map[string]interface{}) *mediaconnect.GrantEntitlementRequest {
+	apiObject := &mediaconnect.GrantEntitlementRequest{
+		Encryption:  expandEncryption(tfMap["encryption"].([]interface{})),
+		Name:        aws.String(tfMap["name"].(string)),
+		Subscribers: flex.ExpandStringList(tfMap["subscribers"].([]interface{})),
+	}
+
+	if v, ok := tfMap["data_transfer_subscriber_fee_percent"].(int); ok && v != 0 {
+		apiObject.DataTransferSubscriberFeePercent = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+		apiObject.EntitlementStatus = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEntitlements(apiObjects []*mediaconnect.Entitlement) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                                  aws.StringValue(apiObject.EntitlementArn),
+			"data_transfer_subscriber_fee_percent": aws.Int64Value(apiObject.DataTransferSubscriberFeePercent),
+			"description":                          aws.StringValue(apiObject.Description),
+			"encryption":                           flattenEncryption(apiObject.Encryption),
+			"entitlement_status":                   aws.StringValue(apiObject.EntitlementStatus),
+			"name":                                 aws.StringValue(apiObject.Name),
+			"subscribers":                          aws.StringValueSlice(apiObject.Subscribers),
+		})
+	}
+
+	return tfList
+}
+
+func expandVPCInterfaceRequests(tfList []interface{}) []*mediaconnect.VpcInterfaceRequest {
+	var apiObjects []*mediaconnect.VpcInterfaceRequest
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.VpcInterfaceRequest{
+			Name:             aws.String(tfMap["name"].(string)),
+			RoleArn:          aws.String(tfMap["role_arn"].(string)),
+			SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+			SubnetId:         aws.String(tfMap["subnet_id"].(string)),
+		}
+
+		if v, ok := tfMap["network_interface_type"].(string); ok && v != "" {
+			apiObject.NetworkInterfaceType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenVPCInterfaces(apiObjects []*mediaconnect.VpcInterface) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                   aws.StringValue(apiObject.Name),
+			"network_interface_ids":  aws.StringValueSlice(apiObject.NetworkInterfaceIds),
+			"network_interface_type": aws.StringValue(apiObject.NetworkInterfaceType),
+			"role_arn":               aws.StringValue(apiObject.RoleArn),
+			"security_group_ids":     flex.FlattenStringSet(apiObject.SecurityGroupIds),
+			"subnet_id":              aws.StringValue(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
+
+func expandAddMediaStreamRequests(tfList []interface{}) []*mediaconnect.AddMediaStreamRequest {
+	var apiObjects []*mediaconnect.AddMediaStreamRequest
+
+	for _, v := range tfList {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObjects = append(apiObjects, expandAddMediaStreamRequest(tfMap))
+		}
+	}
+
+	return apiObjects
+}
+
+func expandAddMediaStreamRequest(tfMap map[string]interface{}) *mediaconnect.AddMediaStreamRequest {
+	apiObject := &mediaconnect.AddMediaStreamRequest{
+		MediaStreamId:   aws.Int64(int64(tfMap["media_stream_id"].(int))),
+		MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		MediaStreamType: aws.String(tfMap["media_stream_type"].(string)),
+	}
+
+	if v, ok := tfMap["attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		attributes := &mediaconnect.MediaStreamAttributesRequest{}
+
+		if v, ok := tfMap["fmtp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			fmtp := &mediaconnect.FmtpRequest{}
+
+			if v, ok := tfMap["channel_order"].(string); ok && v != "" {
+				fmtp.ChannelOrder = aws.String(v)
+			}
+
+			if v, ok := tfMap["colorimetry"].(string); ok && v != "" {
+				fmtp.Colorimetry = aws.String(v)
+			}
+
+			if v, ok := tfMap["exact_framerate"].(string); ok && v != "" {
+				fmtp.ExactFramerate = aws.String(v)
+			}
+
+			if v, ok := tfMap["par"].(string); ok && v != "" {
+				fmtp.Par = aws.String(v)
+			}
+
+			if v, ok := tfMap["range"].(string); ok && v != "" {
+				fmtp.Range = aws.String(v)
+			}
+
+			if v, ok := tfMap["scan_mode"].(string); ok && v != "" {
+				fmtp.ScanMode = aws.String(v)
+			}
+
+			if v, ok := tfMap["tcs"].(string); ok && v != "" {
+				fmtp.Tcs = aws.String(v)
+			}
+
+			attributes.Fmtp = fmtp
+		}
+
+		if v, ok := tfMap["lang"].(string); ok && v != "" {
+			attributes.Lang = aws.String(v)
+		}
+
+		apiObject.Attributes = attributes
+	}
+
+	if v, ok := tfMap["clock_rate"].(int); ok && v != 0 {
+		apiObject.ClockRate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["video_format"].(string); ok && v != "" {
+		apiObject.VideoFormat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMediaStreams(apiObjects []*mediaconnect.MediaStream) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"clock_rate":        aws.Int64Value(apiObject.ClockRate),
+			"description":       aws.StringValue(apiObject.Description),
+			"fmt":               aws.Int64Value(apiObject.Fmt),
+			"media_stream_id":   aws.Int64Value(apiObject.MediaStreamId),
+			"media_stream_name": aws.StringValue(apiObject.MediaStreamName),
+			"media_stream_type": aws.StringValue(apiObject.MediaStreamType),
+			"video_format":      aws.StringValue(apiObject.VideoFormat),
+		}
+
+		if v := apiObject.Attributes; v != nil {
+			attributes := map[string]interface{}{
+				"lang": aws.StringValue(v.Lang),
+			}
+
+			if v := v.Fmtp; v != nil {
+				attributes["fmtp"] = []interface{}{map[string]interface{}{
+					"channel_order":   aws.StringValue(v.ChannelOrder),
+					"colorimetry":     aws.StringValue(v.Colorimetry),
+					"exact_framerate": aws.StringValue(v.ExactFramerate),
+					"par":             aws.StringValue(v.Par),
+					"range":           aws.StringValue(v.Range),
+					"scan_mode":       aws.StringValue(v.ScanMode),
+					"tcs":             aws.StringValue(v.Tcs),
+				}}
+			}
+
+			tfMap["attributes"] = []interface{}{attributes}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandFailoverConfig(tfMap map[string]interface{}) *mediaconnect.FailoverConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["source_priority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePriority = &mediaconnect.SourcePriority{
+			PrimarySource: aws.String(v[0].(map[string]interface{})["primary_source"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateFailoverConfig(tfMap map[string]interface{}) *mediaconnect.UpdateFailoverConfig {
+	apiObject := expandFailoverConfig(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateFailoverConfig{
+		FailoverMode:   apiObject.FailoverMode,
+		RecoveryWindow: apiObject.RecoveryWindow,
+		SourcePriority: apiObject.SourcePriority,
+		State:          apiObject.State,
+	}
+}
+
+func flattenFailoverConfig(apiObject *mediaconnect.FailoverConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover_mode":   aws.StringValue(apiObject.FailoverMode),
+		"recovery_window": aws.Int64Value(apiObject.RecoveryWindow),
+		"state":           aws.StringValue(apiObject.State),
+	}
+
+	if v := apiObject.SourcePriority; v != nil && v.PrimarySource != nil {
+		tfMap["source_priority"] = []interface{}{map[string]interface{}{
+			"primary_source": aws.StringValue(v.PrimarySource),
+		}}
+	}
+
+	return tfMap
+}
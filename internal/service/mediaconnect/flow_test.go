@@ -0,0 +1,508 @@
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConnectFlow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconnect", regexp.MustCompile(`flow:.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "media_stream.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.ingest_ip"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.protocol", "zixi-push"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.24.34.0/23"),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_outputsAndEntitlements(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_outputsAndEntitlements(rName, "First output"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "entitlement.0.arn"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.entitlement_status", mediaconnect.EntitlementStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", "entitlement1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.subscribers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "output.0.arn"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.description", "First output"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.destination", "198.51.100.11"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.protocol", "rtp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_outputsAndEntitlementsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.entitlement_status", mediaconnect.EntitlementStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", "entitlement1"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.description", "Updated output"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "1025"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.destination", "198.51.100.12"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.name", "output2"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.protocol", "zixi-push"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_sourceFailover(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_sourceFailover(rName, mediaconnect.FailoverModeFailover, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source1"),
+					resource.TestCheckResourceAttr(resourceName, "source.1.name", "source2"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.failover_mode", mediaconnect.FailoverModeFailover),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.recovery_window", "200"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.source_priority.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.source_priority.0.primary_source", "source1"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.state", mediaconnect.StateEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_sourceFailover(rName, mediaconnect.FailoverModeFailover, 500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.recovery_window", "500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_startFlow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediaconnect.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_startFlow(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_flow"},
+			},
+			{
+				Config: testAccFlowConfig_startFlow(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowExists(ctx context.Context, n string, v *mediaconnect.Flow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn()
+
+		output, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn()
+
+	input := &mediaconnect.ListFlowsInput{}
+	_, err := conn.ListFlowsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccFlowConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "zixi-push"
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "zixi-push"
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFlowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "zixi-push"
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccFlowConfig_outputsAndEntitlements(rName, outputDescription string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "zixi-push"
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  output {
+    name        = "output1"
+    description = %[2]q
+    destination = "198.51.100.11"
+    port        = 1024
+    protocol    = "rtp"
+  }
+
+  entitlement {
+    name        = "entitlement1"
+    subscribers = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName, outputDescription)
+}
+
+func testAccFlowConfig_outputsAndEntitlementsUpdated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "zixi-push"
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  output {
+    name        = "output1"
+    description = "Updated output"
+    destination = "198.51.100.11"
+    port        = 1025
+    protocol    = "rtp"
+  }
+
+  output {
+    name        = "output2"
+    destination = "198.51.100.12"
+    port        = 2088
+    protocol    = "zixi-push"
+    stream_id   = "stream2"
+  }
+
+  entitlement {
+    name               = "entitlement1"
+    description        = "Updated"
+    entitlement_status = "DISABLED"
+    subscribers        = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_sourceFailover(rName, failoverMode string, recoveryWindow int) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  source {
+    name           = "source2"
+    protocol       = "rtp"
+    ingest_port    = 5001
+    whitelist_cidr = "10.24.36.0/23"
+  }
+
+  source_failover_config {
+    failover_mode   = %[2]q
+    recovery_window = %[3]d
+    state           = "ENABLED"
+
+    source_priority {
+      primary_source = "source1"
+    }
+  }
+}
+`, rName, failoverMode, recoveryWindow)
+}
+
+func testAccFlowConfig_startFlow(rName string, startFlow bool) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name       = %[1]q
+  start_flow = %[2]t
+
+  source {
+    name           = "source1"
+    protocol       = "zixi-push"
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName, startFlow)
+}
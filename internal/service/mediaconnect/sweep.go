@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package mediaconnect
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_mediaconnect_flow", &resource.Sweeper{
+		Name: "aws_mediaconnect_flow",
+		F:    sweepFlows,
+	})
+}
+
+func sweepFlows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MediaConnectConn()
+	input := &mediaconnect.ListFlowsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListFlowsPagesWithContext(ctx, input, func(page *mediaconnect.ListFlowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Flows {
+			r := ResourceFlow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.FlowArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaConnect Flow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaConnect Flows (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaConnect Flows (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
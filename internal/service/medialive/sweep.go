@@ -16,17 +16,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_medialive_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_channel", &resource.Sweeper{
 		Name: "aws_medialive_channel",
 		F:    sweepChannels,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
 		Name: "aws_medialive_input",
 		F:    sweepInputs,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
 		Name: "aws_medialive_input_security_group",
 		F:    sweepInputSecurityGroups,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
 		Name: "aws_medialive_multiplex",
 		F:    sweepMultiplexes,
 	})
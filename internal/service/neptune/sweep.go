@@ -18,12 +18,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
 		Name: "aws_neptune_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
 		Name: "aws_neptune_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -31,7 +31,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
 		Name: "aws_neptune_cluster_instance",
 		F:    sweepClusterInstances,
 		Dependencies: []string{
@@ -58,27 +58,30 @@ func sweepEventSubscriptions(region string) error {
 			name := aws.StringValue(eventSubscription.CustSubscriptionId)
 
 			log.Printf("[INFO] Deleting Neptune Event Subscription: %s", name)
-			_, err = conn.DeleteEventSubscriptionWithContext(ctx, &neptune.DeleteEventSubscriptionInput{
-				SubscriptionName: aws.String(name),
-			})
-			if tfawserr.ErrCodeEquals(err, neptune.ErrCodeSubscriptionNotFoundFault) {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("deleting Neptune Event Subscription (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			err := sweep.DeleteFunc(ctx, client, "aws_neptune_event_subscription", name, func() error {
+				_, err := conn.DeleteEventSubscriptionWithContext(ctx, &neptune.DeleteEventSubscriptionInput{
+					SubscriptionName: aws.String(name),
+				})
+				if tfawserr.ErrCodeEquals(err, neptune.ErrCodeSubscriptionNotFoundFault) {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("deleting Neptune Event Subscription (%s): %w", name, err)
+				}
 
-			_, err = WaitEventSubscriptionDeleted(ctx, conn, name)
-			if tfawserr.ErrCodeEquals(err, neptune.ErrCodeSubscriptionNotFoundFault) {
-				continue
-			}
+				_, err = WaitEventSubscriptionDeleted(ctx, conn, name)
+				if tfawserr.ErrCodeEquals(err, neptune.ErrCodeSubscriptionNotFoundFault) {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("waiting for Neptune Event Subscription (%s) deletion: %w", name, err)
+				}
+
+				return nil
+			})
 			if err != nil {
-				sweeperErr := fmt.Errorf("waiting for Neptune Event Subscription (%s) deletion: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
 			}
 		}
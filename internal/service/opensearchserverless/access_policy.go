@@ -0,0 +1,210 @@
+package opensearchserverless
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_opensearchserverless_access_policy", name="Access Policy")
+func ResourceAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessPolicyCreate,
+		ReadWithoutTimeout:   resourceAccessPolicyRead,
+		UpdateWithoutTimeout: resourceAccessPolicyUpdate,
+		DeleteWithoutTimeout: resourceAccessPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccessPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.All(validation.StringLenBetween(1, 20480), validation.StringIsJSON),
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessPolicyType](),
+			},
+		},
+	}
+}
+
+func resourceAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateAccessPolicyInput{
+		Name:   aws.String(name),
+		Policy: aws.String(d.Get("policy").(string)),
+		Type:   types.AccessPolicyType(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAccessPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Serverless Access Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.AccessPolicyDetail.Name))
+
+	return append(diags, resourceAccessPolicyRead(ctx, d, meta)...)
+}
+
+func resourceAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	accessPolicy, err := FindAccessPolicyByNameAndType(ctx, conn, d.Id(), d.Get("type").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Access Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Serverless Access Policy (%s): %s", d.Id(), err)
+	}
+
+	policy, err := policyDocumentToString(d.Get("policy").(string), accessPolicy.Policy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Serverless Access Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", accessPolicy.Description)
+	d.Set("name", accessPolicy.Name)
+	d.Set("policy", policy)
+	d.Set("policy_version", accessPolicy.PolicyVersion)
+	d.Set("type", accessPolicy.Type)
+
+	return diags
+}
+
+func resourceAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	input := &opensearchserverless.UpdateAccessPolicyInput{
+		Name:          aws.String(d.Id()),
+		PolicyVersion: aws.String(d.Get("policy_version").(string)),
+		Type:          types.AccessPolicyType(d.Get("type").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("policy") {
+		input.Policy = aws.String(d.Get("policy").(string))
+	}
+
+	_, err := conn.UpdateAccessPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Serverless Access Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAccessPolicyRead(ctx, d, meta)...)
+}
+
+func resourceAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Access Policy: %s", d.Id())
+	_, err := conn.DeleteAccessPolicy(ctx, &opensearchserverless.DeleteAccessPolicyInput{
+		Name: aws.String(d.Id()),
+		Type: types.AccessPolicyType(d.Get("type").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Serverless Access Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceAccessPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, policyType, err := policyParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(name)
+	d.Set("name", name)
+	d.Set("type", policyType)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func FindAccessPolicyByNameAndType(ctx context.Context, conn *opensearchserverless.Client, name, policyType string) (*types.AccessPolicyDetail, error) {
+	input := &opensearchserverless.GetAccessPolicyInput{
+		Name: aws.String(name),
+		Type: types.AccessPolicyType(policyType),
+	}
+
+	output, err := conn.GetAccessPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessPolicyDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessPolicyDetail, nil
+}
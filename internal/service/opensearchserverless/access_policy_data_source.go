@@ -0,0 +1,83 @@
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_opensearchserverless_access_policy")
+func DataSourceAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccessPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateName,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessPolicyType](),
+			},
+		},
+	}
+}
+
+func dataSourceAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	accessPolicy, err := FindAccessPolicyByNameAndType(ctx, conn, name, d.Get("type").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("OpenSearch Serverless Access Policy", err))
+	}
+
+	policy, err := policyDocumentToString("", accessPolicy.Policy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Serverless Access Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(accessPolicy.Name))
+	d.Set("created_date", flattenEpochMillis(accessPolicy.CreatedDate))
+	d.Set("description", accessPolicy.Description)
+	d.Set("last_modified_date", flattenEpochMillis(accessPolicy.LastModifiedDate))
+	d.Set("name", accessPolicy.Name)
+	d.Set("policy", policy)
+	d.Set("policy_version", accessPolicy.PolicyVersion)
+	d.Set("type", accessPolicy.Type)
+
+	return diags
+}
@@ -0,0 +1,286 @@
+package opensearchserverless
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_opensearchserverless_collection", name="Collection")
+// @Tags(identifierAttribute="arn")
+func ResourceCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCollectionCreate,
+		ReadWithoutTimeout:   resourceCollectionRead,
+		UpdateWithoutTimeout: resourceCollectionUpdate,
+		DeleteWithoutTimeout: resourceCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.CollectionType](),
+			},
+		},
+	}
+}
+
+// validateName validates the names of collections, policies and VPC endpoints.
+var validateName = validation.All(
+	validation.StringLenBetween(3, 32),
+	validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+)
+
+func resourceCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateCollectionInput{
+		Name: aws.String(name),
+		Tags: GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = types.CollectionType(v.(string))
+	}
+
+	output, err := conn.CreateCollection(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Serverless Collection (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.CreateCollectionDetail.Id))
+
+	if _, err := waitCollectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Serverless Collection (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCollectionRead(ctx, d, meta)...)
+}
+
+func resourceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	collection, err := FindCollectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Serverless Collection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", collection.Arn)
+	d.Set("collection_endpoint", collection.CollectionEndpoint)
+	d.Set("dashboard_endpoint", collection.DashboardEndpoint)
+	d.Set("description", collection.Description)
+	d.Set("kms_key_arn", collection.KmsKeyArn)
+	d.Set("name", collection.Name)
+	d.Set("type", collection.Type)
+
+	return diags
+}
+
+func resourceCollectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	if d.HasChange("description") {
+		input := &opensearchserverless.UpdateCollectionInput{
+			Description: aws.String(d.Get("description").(string)),
+			Id:          aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateCollection(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Serverless Collection (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCollectionRead(ctx, d, meta)...)
+}
+
+func resourceCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Collection: %s", d.Id())
+	_, err := conn.DeleteCollection(ctx, &opensearchserverless.DeleteCollectionInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Serverless Collection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCollectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Serverless Collection (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCollectionByID(ctx context.Context, conn *opensearchserverless.Client, id string) (*types.CollectionDetail, error) {
+	input := &opensearchserverless.BatchGetCollectionInput{
+		Ids: []string{id},
+	}
+
+	return findCollection(ctx, conn, input)
+}
+
+func findCollectionByName(ctx context.Context, conn *opensearchserverless.Client, name string) (*types.CollectionDetail, error) {
+	input := &opensearchserverless.BatchGetCollectionInput{
+		Names: []string{name},
+	}
+
+	return findCollection(ctx, conn, input)
+}
+
+func findCollection(ctx context.Context, conn *opensearchserverless.Client, input *opensearchserverless.BatchGetCollectionInput) (*types.CollectionDetail, error) {
+	output, err := conn.BatchGetCollection(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CollectionDetails) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CollectionDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.CollectionDetails[0], nil
+}
+
+func statusCollection(ctx context.Context, conn *opensearchserverless.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCollectionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*types.CollectionDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CollectionStatusCreating),
+		Target:  enum.Slice(types.CollectionStatusActive),
+		Refresh: statusCollection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CollectionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCollectionDeleted(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*types.CollectionDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CollectionStatusDeleting),
+		Target:  []string{},
+		Refresh: statusCollection(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CollectionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,123 @@
+package opensearchserverless
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_opensearchserverless_collection")
+func DataSourceCollection() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCollectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var collection *types.CollectionDetail
+	var err error
+
+	if v, ok := d.GetOk("id"); ok {
+		collection, err = FindCollectionByID(ctx, conn, v.(string))
+	} else {
+		collection, err = findCollectionByName(ctx, conn, d.Get("name").(string))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("OpenSearch Serverless Collection", err))
+	}
+
+	d.SetId(aws.ToString(collection.Id))
+	arn := aws.ToString(collection.Arn)
+	d.Set("arn", arn)
+	d.Set("collection_endpoint", collection.CollectionEndpoint)
+	d.Set("created_date", flattenEpochMillis(collection.CreatedDate))
+	d.Set("dashboard_endpoint", collection.DashboardEndpoint)
+	d.Set("description", collection.Description)
+	d.Set("kms_key_arn", collection.KmsKeyArn)
+	d.Set("last_modified_date", flattenEpochMillis(collection.LastModifiedDate))
+	d.Set("name", collection.Name)
+	d.Set("type", collection.Type)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for OpenSearch Serverless Collection (%s): %s", arn, err)
+	}
+
+	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+// flattenEpochMillis formats a timestamp in milliseconds since the Unix epoch as RFC 3339.
+func flattenEpochMillis(v *int64) string {
+	if v == nil {
+		return ""
+	}
+
+	return time.UnixMilli(aws.ToInt64(v)).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,246 @@
+package opensearchserverless
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/document"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_opensearchserverless_security_policy", name="Security Policy")
+func ResourceSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityPolicyCreate,
+		ReadWithoutTimeout:   resourceSecurityPolicyRead,
+		UpdateWithoutTimeout: resourceSecurityPolicyUpdate,
+		DeleteWithoutTimeout: resourceSecurityPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecurityPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.All(validation.StringLenBetween(1, 20480), validation.StringIsJSON),
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.SecurityPolicyType](),
+			},
+		},
+	}
+}
+
+func resourceSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateSecurityPolicyInput{
+		Name:   aws.String(name),
+		Policy: aws.String(d.Get("policy").(string)),
+		Type:   types.SecurityPolicyType(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSecurityPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Serverless Security Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.SecurityPolicyDetail.Name))
+
+	return append(diags, resourceSecurityPolicyRead(ctx, d, meta)...)
+}
+
+func resourceSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	securityPolicy, err := FindSecurityPolicyByNameAndType(ctx, conn, d.Id(), d.Get("type").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Security Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Serverless Security Policy (%s): %s", d.Id(), err)
+	}
+
+	policy, err := policyDocumentToString(d.Get("policy").(string), securityPolicy.Policy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Serverless Security Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", securityPolicy.Description)
+	d.Set("name", securityPolicy.Name)
+	d.Set("policy", policy)
+	d.Set("policy_version", securityPolicy.PolicyVersion)
+	d.Set("type", securityPolicy.Type)
+
+	return diags
+}
+
+func resourceSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	input := &opensearchserverless.UpdateSecurityPolicyInput{
+		Name:          aws.String(d.Id()),
+		PolicyVersion: aws.String(d.Get("policy_version").(string)),
+		Type:          types.SecurityPolicyType(d.Get("type").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("policy") {
+		input.Policy = aws.String(d.Get("policy").(string))
+	}
+
+	_, err := conn.UpdateSecurityPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Serverless Security Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSecurityPolicyRead(ctx, d, meta)...)
+}
+
+func resourceSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Security Policy: %s", d.Id())
+	_, err := conn.DeleteSecurityPolicy(ctx, &opensearchserverless.DeleteSecurityPolicyInput{
+		Name: aws.String(d.Id()),
+		Type: types.SecurityPolicyType(d.Get("type").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Serverless Security Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSecurityPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, policyType, err := policyParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(name)
+	d.Set("name", name)
+	d.Set("type", policyType)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func FindSecurityPolicyByNameAndType(ctx context.Context, conn *opensearchserverless.Client, name, policyType string) (*types.SecurityPolicyDetail, error) {
+	input := &opensearchserverless.GetSecurityPolicyInput{
+		Name: aws.String(name),
+		Type: types.SecurityPolicyType(policyType),
+	}
+
+	output, err := conn.GetSecurityPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityPolicyDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityPolicyDetail, nil
+}
+
+const policyImportIDSeparator = "/"
+
+// policyParseImportID parses a security or access policy import ID of the form "name/type".
+func policyParseImportID(id string) (string, string, error) {
+	parts := strings.Split(id, policyImportIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected name%[2]stype", id, policyImportIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// policyDocumentToString returns the JSON encoding of a security or access policy document.
+// The existing policy is returned if it is equivalent, to avoid spurious differences in formatting.
+func policyDocumentToString(existing string, policy document.Interface) (string, error) {
+	if policy == nil {
+		return "", nil
+	}
+
+	b, err := policy.MarshalSmithyDocument()
+
+	if err != nil {
+		return "", err
+	}
+
+	if verify.JSONStringsEqual(existing, string(b)) {
+		return existing, nil
+	}
+
+	return string(b), nil
+}
@@ -20,11 +20,52 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAccessPolicy,
+			TypeName: "aws_opensearchserverless_access_policy",
+		},
+		{
+			Factory:  DataSourceCollection,
+			TypeName: "aws_opensearchserverless_collection",
+		},
+		{
+			Factory:  DataSourceSecurityPolicy,
+			TypeName: "aws_opensearchserverless_security_policy",
+		},
+		{
+			Factory:  DataSourceVPCEndpoint,
+			TypeName: "aws_opensearchserverless_vpc_endpoint",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAccessPolicy,
+			TypeName: "aws_opensearchserverless_access_policy",
+			Name:     "Access Policy",
+		},
+		{
+			Factory:  ResourceCollection,
+			TypeName: "aws_opensearchserverless_collection",
+			Name:     "Collection",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceSecurityPolicy,
+			TypeName: "aws_opensearchserverless_security_policy",
+			Name:     "Security Policy",
+		},
+		{
+			Factory:  ResourceVPCEndpoint,
+			TypeName: "aws_opensearchserverless_vpc_endpoint",
+			Name:     "VPC Endpoint",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,226 @@
+//go:build sweep
+// +build sweep
+
+package opensearchserverless
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_opensearchserverless_access_policy", &resource.Sweeper{
+		Name: "aws_opensearchserverless_access_policy",
+		F:    sweepAccessPolicies,
+	})
+
+	sweep.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
+		Name: "aws_opensearchserverless_collection",
+		F:    sweepCollections,
+	})
+
+	sweep.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
+		Name: "aws_opensearchserverless_security_policy",
+		F:    sweepSecurityPolicies,
+		Dependencies: []string{
+			"aws_opensearchserverless_collection",
+			"aws_opensearchserverless_vpc_endpoint",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
+		Name: "aws_opensearchserverless_vpc_endpoint",
+		F:    sweepVPCEndpoints,
+	})
+}
+
+func sweepAccessPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchServerlessClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	for _, policyType := range enum.Values[types.AccessPolicyType]() {
+		paginator := opensearchserverless.NewListAccessPoliciesPaginator(conn, &opensearchserverless.ListAccessPoliciesInput{
+			Type: types.AccessPolicyType(policyType),
+		})
+
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+
+			if sweep.SkipSweepError(err) {
+				log.Printf("[WARN] Skipping OpenSearch Serverless Access Policy sweep for %s: %s", region, err)
+				return nil
+			}
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("listing OpenSearch Serverless Access Policies for %s: %w", region, err))
+				break
+			}
+
+			for _, v := range page.AccessPolicySummaries {
+				r := ResourceAccessPolicy()
+				d := r.Data(nil)
+				d.SetId(aws.ToString(v.Name))
+				d.Set("type", v.Type)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping OpenSearch Serverless Access Policies for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepCollections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchServerlessClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := opensearchserverless.NewListCollectionsPaginator(conn, &opensearchserverless.ListCollectionsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping OpenSearch Serverless Collection sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing OpenSearch Serverless Collections for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range page.CollectionSummaries {
+			r := ResourceCollection()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping OpenSearch Serverless Collections for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSecurityPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchServerlessClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	for _, policyType := range enum.Values[types.SecurityPolicyType]() {
+		paginator := opensearchserverless.NewListSecurityPoliciesPaginator(conn, &opensearchserverless.ListSecurityPoliciesInput{
+			Type: types.SecurityPolicyType(policyType),
+		})
+
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+
+			if sweep.SkipSweepError(err) {
+				log.Printf("[WARN] Skipping OpenSearch Serverless Security Policy sweep for %s: %s", region, err)
+				return nil
+			}
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("listing OpenSearch Serverless Security Policies for %s: %w", region, err))
+				break
+			}
+
+			for _, v := range page.SecurityPolicySummaries {
+				r := ResourceSecurityPolicy()
+				d := r.Data(nil)
+				d.SetId(aws.ToString(v.Name))
+				d.Set("type", v.Type)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping OpenSearch Serverless Security Policies for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepVPCEndpoints(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchServerlessClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := opensearchserverless.NewListVpcEndpointsPaginator(conn, &opensearchserverless.ListVpcEndpointsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping OpenSearch Serverless VPC Endpoint sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing OpenSearch Serverless VPC Endpoints for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range page.VpcEndpointSummaries {
+			r := ResourceVPCEndpoint()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping OpenSearch Serverless VPC Endpoints for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,291 @@
+package opensearchserverless
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_opensearchserverless_vpc_endpoint", name="VPC Endpoint")
+func ResourceVPCEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCEndpointCreate,
+		ReadWithoutTimeout:   resourceVPCEndpointRead,
+		UpdateWithoutTimeout: resourceVPCEndpointUpdate,
+		DeleteWithoutTimeout: resourceVPCEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 6,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateVpcEndpointInput{
+		Name:      aws.String(name),
+		SubnetIds: flex.ExpandStringValueSet(d.Get("subnet_ids").(*schema.Set)),
+		VpcId:     aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateVpcEndpoint(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Serverless VPC Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.CreateVpcEndpointDetail.Id))
+
+	if _, err := waitVPCEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Serverless VPC Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVPCEndpointRead(ctx, d, meta)...)
+}
+
+func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	vpcEndpoint, err := FindVPCEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless VPC Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Serverless VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("name", vpcEndpoint.Name)
+	d.Set("security_group_ids", vpcEndpoint.SecurityGroupIds)
+	d.Set("subnet_ids", vpcEndpoint.SubnetIds)
+	d.Set("vpc_id", vpcEndpoint.VpcId)
+
+	return diags
+}
+
+func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	input := &opensearchserverless.UpdateVpcEndpointInput{
+		Id: aws.String(d.Id()),
+	}
+
+	if d.HasChange("security_group_ids") {
+		o, n := d.GetChange("security_group_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			input.AddSecurityGroupIds = add
+		}
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			input.RemoveSecurityGroupIds = del
+		}
+	}
+
+	if d.HasChange("subnet_ids") {
+		o, n := d.GetChange("subnet_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			input.AddSubnetIds = add
+		}
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			input.RemoveSubnetIds = del
+		}
+	}
+
+	_, err := conn.UpdateVpcEndpoint(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Serverless VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Serverless VPC Endpoint (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceVPCEndpointRead(ctx, d, meta)...)
+}
+
+func resourceVPCEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless VPC Endpoint: %s", d.Id())
+	_, err := conn.DeleteVpcEndpoint(ctx, &opensearchserverless.DeleteVpcEndpointInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Serverless VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Serverless VPC Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindVPCEndpointByID(ctx context.Context, conn *opensearchserverless.Client, id string) (*types.VpcEndpointDetail, error) {
+	input := &opensearchserverless.BatchGetVpcEndpointInput{
+		Ids: []string{id},
+	}
+
+	output, err := conn.BatchGetVpcEndpoint(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.VpcEndpointDetails) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.VpcEndpointDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.VpcEndpointDetails[0], nil
+}
+
+func statusVPCEndpoint(ctx context.Context, conn *opensearchserverless.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCEndpointByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitVPCEndpointCreated(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*types.VpcEndpointDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcEndpointStatusPending),
+		Target:  enum.Slice(types.VpcEndpointStatusActive),
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcEndpointDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointUpdated(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*types.VpcEndpointDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.VpcEndpointStatusPending),
+		Target:                    enum.Slice(types.VpcEndpointStatusActive),
+		Refresh:                   statusVPCEndpoint(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcEndpointDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointDeleted(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*types.VpcEndpointDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcEndpointStatusDeleting, types.VpcEndpointStatusActive),
+		Target:  []string{},
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcEndpointDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,69 @@
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_opensearchserverless_vpc_endpoint")
+func DataSourceVPCEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	vpcEndpoint, err := FindVPCEndpointByID(ctx, conn, d.Get("vpc_endpoint_id").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("OpenSearch Serverless VPC Endpoint", err))
+	}
+
+	d.SetId(aws.ToString(vpcEndpoint.Id))
+	d.Set("created_date", flattenEpochMillis(vpcEndpoint.CreatedDate))
+	d.Set("name", vpcEndpoint.Name)
+	d.Set("security_group_ids", vpcEndpoint.SecurityGroupIds)
+	d.Set("subnet_ids", vpcEndpoint.SubnetIds)
+	d.Set("vpc_endpoint_id", vpcEndpoint.Id)
+	d.Set("vpc_id", vpcEndpoint.VpcId)
+
+	return diags
+}
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_pinpoint_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pinpoint_app", &resource.Sweeper{
 		Name: "aws_pinpoint_app",
 		F:    sweepApps,
 	})
@@ -50,8 +50,12 @@ func sweepApps(region string) error {
 			name := aws.StringValue(item.Name)
 
 			log.Printf("[INFO] Deleting Pinpoint app %s", name)
-			_, err := conn.DeleteAppWithContext(ctx, &pinpoint.DeleteAppInput{
-				ApplicationId: item.Id,
+			err := sweep.DeleteFunc(ctx, client, "aws_pinpoint_app", aws.StringValue(item.Id), func() error {
+				_, err := conn.DeleteAppWithContext(ctx, &pinpoint.DeleteAppInput{
+					ApplicationId: item.Id,
+				})
+
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("Error deleting Pinpoint app %s: %s", name, err)
@@ -0,0 +1,105 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func httpParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"header_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"path_parameter_values": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"query_string_parameters": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandPipeEnrichmentParameters(tfMap map[string]interface{}) *types.PipeEnrichmentParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeEnrichmentParameters{}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		httpParameters := &types.PipeEnrichmentHttpParameters{}
+
+		if v, ok := tfMap["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			httpParameters.HeaderParameters = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+			httpParameters.PathParameterValues = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			httpParameters.QueryStringParameters = flex.ExpandStringValueMap(v)
+		}
+
+		apiObject.HttpParameters = httpParameters
+	}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPipeEnrichmentParameters(apiObject *types.PipeEnrichmentParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HttpParameters; v != nil {
+		tfMap["http_parameters"] = []interface{}{flattenHTTPParameters(v.HeaderParameters, v.PathParameterValues, v.QueryStringParameters)}
+	}
+
+	if v := apiObject.InputTemplate; v != nil {
+		tfMap["input_template"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenHTTPParameters(headerParameters map[string]string, pathParameterValues []string, queryStringParameters map[string]string) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if len(headerParameters) > 0 {
+		tfMap["header_parameters"] = headerParameters
+	}
+
+	if len(pathParameterValues) > 0 {
+		tfMap["path_parameter_values"] = flex.FlattenStringValueList(pathParameterValues)
+	}
+
+	if len(queryStringParameters) > 0 {
+		tfMap["query_string_parameters"] = queryStringParameters
+	}
+
+	return tfMap
+}
@@ -0,0 +1,6 @@
+package pipes
+
+// Exports for use in tests only.
+var (
+	FindPipeByName = findPipeByName
+)
@@ -0,0 +1,850 @@
+package pipes
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func sourceParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dynamodb_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"source_parameters.0.kinesis_stream_parameters", "source_parameters.0.managed_streaming_kafka_parameters", "source_parameters.0.sqs_queue_parameters"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config": deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"maximum_record_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.Any(validation.IntInSlice([]int{-1}), validation.IntBetween(60, 604_800)),
+							},
+							"maximum_retry_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 10_000),
+							},
+							"on_partial_batch_item_failure": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[types.OnPartialBatchItemFailureStreams](),
+							},
+							"parallelization_factor": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"starting_position": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[types.DynamoDBStreamStartPosition](),
+							},
+						},
+					},
+				},
+				"filter_criteria": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"filter": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 5,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"pattern": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(0, 4096),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"kinesis_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"source_parameters.0.dynamodb_stream_parameters", "source_parameters.0.managed_streaming_kafka_parameters", "source_parameters.0.sqs_queue_parameters"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"dead_letter_config": deadLetterConfigSchema(),
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"maximum_record_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.Any(validation.IntInSlice([]int{-1}), validation.IntBetween(60, 604_800)),
+							},
+							"maximum_retry_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(-1, 10_000),
+							},
+							"on_partial_batch_item_failure": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[types.OnPartialBatchItemFailureStreams](),
+							},
+							"parallelization_factor": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"starting_position": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[types.KinesisStreamStartPosition](),
+							},
+							"starting_position_timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+							},
+						},
+					},
+				},
+				"managed_streaming_kafka_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"source_parameters.0.dynamodb_stream_parameters", "source_parameters.0.kinesis_stream_parameters", "source_parameters.0.sqs_queue_parameters"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"consumer_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+							"credentials": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"client_certificate_tls_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"sasl_scram_512_auth": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"starting_position": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Computed:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[types.MSKStartPosition](),
+							},
+							"topic_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringLenBetween(1, 249),
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"source_parameters.0.dynamodb_stream_parameters", "source_parameters.0.kinesis_stream_parameters", "source_parameters.0.managed_streaming_kafka_parameters"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 10000),
+							},
+							"maximum_batching_window_in_seconds": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func deadLetterConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func expandPipeSourceParameters(tfMap map[string]interface{}) *types.PipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceParameters{}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDBStreamParameters = expandPipeSourceDynamoDBStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamParameters = expandPipeSourceKinesisStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedStreamingKafkaParameters = expandPipeSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsQueueParameters = expandPipeSourceSQSQueueParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceParameters(tfMap map[string]interface{}) *types.UpdatePipeSourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UpdatePipeSourceParameters{
+		// Omitting the filter criteria leaves them unchanged, so send empty criteria to remove them.
+		FilterCriteria: &types.FilterCriteria{},
+	}
+
+	if v, ok := tfMap["dynamodb_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDBStreamParameters = expandUpdatePipeSourceDynamoDBStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["filter_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FilterCriteria = expandFilterCriteria(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamParameters = expandUpdatePipeSourceKinesisStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_streaming_kafka_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedStreamingKafkaParameters = expandUpdatePipeSourceManagedStreamingKafkaParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsQueueParameters = expandUpdatePipeSourceSQSQueueParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *types.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FilterCriteria{}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			filter := types.Filter{}
+
+			if v, ok := tfMap["pattern"].(string); ok && v != "" {
+				filter.Pattern = aws.String(v)
+			}
+
+			apiObject.Filters = append(apiObject.Filters, filter)
+		}
+	}
+
+	return apiObject
+}
+
+func expandDeadLetterConfig(tfMap map[string]interface{}) *types.DeadLetterConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DeadLetterConfig{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeSourceDynamoDBStreamParameters(tfMap map[string]interface{}) *types.PipeSourceDynamoDBStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceDynamoDBStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = types.OnPartialBatchItemFailureStreams(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = types.DynamoDBStreamStartPosition(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceDynamoDBStreamParameters(tfMap map[string]interface{}) *types.UpdatePipeSourceDynamoDBStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UpdatePipeSourceDynamoDBStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = types.OnPartialBatchItemFailureStreams(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandPipeSourceKinesisStreamParameters(tfMap map[string]interface{}) *types.PipeSourceKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceKinesisStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = types.OnPartialBatchItemFailureStreams(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = types.KinesisStreamStartPosition(v)
+	}
+
+	if v, ok := tfMap["starting_position_timestamp"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.StartingPositionTimestamp = aws.Time(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceKinesisStreamParameters(tfMap map[string]interface{}) *types.UpdatePipeSourceKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UpdatePipeSourceKinesisStreamParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["dead_letter_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeadLetterConfig = expandDeadLetterConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_record_age_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumRecordAgeInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_retry_attempts"].(int); ok && v != 0 {
+		apiObject.MaximumRetryAttempts = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["on_partial_batch_item_failure"].(string); ok && v != "" {
+		apiObject.OnPartialBatchItemFailure = types.OnPartialBatchItemFailureStreams(v)
+	}
+
+	if v, ok := tfMap["parallelization_factor"].(int); ok && v != 0 {
+		apiObject.ParallelizationFactor = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandMSKAccessCredentials(tfMap map[string]interface{}) types.MSKAccessCredentials {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["client_certificate_tls_auth"].(string); ok && v != "" {
+		return &types.MSKAccessCredentialsMemberClientCertificateTlsAuth{
+			Value: v,
+		}
+	}
+
+	if v, ok := tfMap["sasl_scram_512_auth"].(string); ok && v != "" {
+		return &types.MSKAccessCredentialsMemberSaslScram512Auth{
+			Value: v,
+		}
+	}
+
+	return nil
+}
+
+func expandPipeSourceManagedStreamingKafkaParameters(tfMap map[string]interface{}) *types.PipeSourceManagedStreamingKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceManagedStreamingKafkaParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["consumer_group_id"].(string); ok && v != "" {
+		apiObject.ConsumerGroupID = aws.String(v)
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMSKAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["starting_position"].(string); ok && v != "" {
+		apiObject.StartingPosition = types.MSKStartPosition(v)
+	}
+
+	if v, ok := tfMap["topic_name"].(string); ok && v != "" {
+		apiObject.TopicName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceManagedStreamingKafkaParameters(tfMap map[string]interface{}) *types.UpdatePipeSourceManagedStreamingKafkaParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UpdatePipeSourceManagedStreamingKafkaParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Credentials = expandMSKAccessCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandPipeSourceSQSQueueParameters(tfMap map[string]interface{}) *types.PipeSourceSqsQueueParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeSourceSqsQueueParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandUpdatePipeSourceSQSQueueParameters(tfMap map[string]interface{}) *types.UpdatePipeSourceSqsQueueParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UpdatePipeSourceSqsQueueParameters{}
+
+	if v, ok := tfMap["batch_size"].(int); ok && v != 0 {
+		apiObject.BatchSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["maximum_batching_window_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaximumBatchingWindowInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenPipeSourceParameters(apiObject *types.PipeSourceParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DynamoDBStreamParameters; v != nil {
+		tfMap["dynamodb_stream_parameters"] = []interface{}{flattenPipeSourceDynamoDBStreamParameters(v)}
+	}
+
+	if v := apiObject.FilterCriteria; v != nil && len(v.Filters) > 0 {
+		tfMap["filter_criteria"] = []interface{}{flattenFilterCriteria(v)}
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{flattenPipeSourceKinesisStreamParameters(v)}
+	}
+
+	if v := apiObject.ManagedStreamingKafkaParameters; v != nil {
+		tfMap["managed_streaming_kafka_parameters"] = []interface{}{flattenPipeSourceManagedStreamingKafkaParameters(v)}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{flattenPipeSourceSQSQueueParameters(v)}
+	}
+
+	return tfMap
+}
+
+func flattenFilterCriteria(apiObject *types.FilterCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfList := []interface{}{}
+
+	for _, filter := range apiObject.Filters {
+		tfMap := map[string]interface{}{}
+
+		if v := filter.Pattern; v != nil {
+			tfMap["pattern"] = aws.ToString(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return map[string]interface{}{
+		"filter": tfList,
+	}
+}
+
+func flattenDeadLetterConfig(apiObject *types.DeadLetterConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenPipeSourceDynamoDBStreamParameters(apiObject *types.PipeSourceDynamoDBStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"on_partial_batch_item_failure": string(apiObject.OnPartialBatchItemFailure),
+		"starting_position":             string(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.BatchSize; v != nil {
+		tfMap["batch_size"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	if v := apiObject.MaximumBatchingWindowInSeconds; v != nil {
+		tfMap["maximum_batching_window_in_seconds"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaximumRecordAgeInSeconds; v != nil {
+		tfMap["maximum_record_age_in_seconds"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaximumRetryAttempts; v != nil {
+		tfMap["maximum_retry_attempts"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.ParallelizationFactor; v != nil {
+		tfMap["parallelization_factor"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func flattenPipeSourceKinesisStreamParameters(apiObject *types.PipeSourceKinesisStreamParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"on_partial_batch_item_failure": string(apiObject.OnPartialBatchItemFailure),
+		"starting_position":             string(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.BatchSize; v != nil {
+		tfMap["batch_size"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.DeadLetterConfig; v != nil {
+		tfMap["dead_letter_config"] = []interface{}{flattenDeadLetterConfig(v)}
+	}
+
+	if v := apiObject.MaximumBatchingWindowInSeconds; v != nil {
+		tfMap["maximum_batching_window_in_seconds"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaximumRecordAgeInSeconds; v != nil {
+		tfMap["maximum_record_age_in_seconds"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaximumRetryAttempts; v != nil {
+		tfMap["maximum_retry_attempts"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.ParallelizationFactor; v != nil {
+		tfMap["parallelization_factor"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.StartingPositionTimestamp; v != nil {
+		tfMap["starting_position_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenMSKAccessCredentials(apiObject types.MSKAccessCredentials) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.MSKAccessCredentialsMemberClientCertificateTlsAuth:
+		tfMap["client_certificate_tls_auth"] = v.Value
+	case *types.MSKAccessCredentialsMemberSaslScram512Auth:
+		tfMap["sasl_scram_512_auth"] = v.Value
+	default:
+		return nil
+	}
+
+	return tfMap
+}
+
+func flattenPipeSourceManagedStreamingKafkaParameters(apiObject *types.PipeSourceManagedStreamingKafkaParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"starting_position": string(apiObject.StartingPosition),
+	}
+
+	if v := apiObject.BatchSize; v != nil {
+		tfMap["batch_size"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.ConsumerGroupID; v != nil {
+		tfMap["consumer_group_id"] = aws.ToString(v)
+	}
+
+	if v := flattenMSKAccessCredentials(apiObject.Credentials); v != nil {
+		tfMap["credentials"] = []interface{}{v}
+	}
+
+	if v := apiObject.MaximumBatchingWindowInSeconds; v != nil {
+		tfMap["maximum_batching_window_in_seconds"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.TopicName; v != nil {
+		tfMap["topic_name"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenPipeSourceSQSQueueParameters(apiObject *types.PipeSourceSqsQueueParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BatchSize; v != nil {
+		tfMap["batch_size"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaximumBatchingWindowInSeconds; v != nil {
+		tfMap["maximum_batching_window_in_seconds"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,320 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func targetParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"eventbridge_event_bus_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"detail_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 128),
+							},
+							"endpoint_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 50),
+							},
+							"resources": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidARN,
+								},
+							},
+							"source": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"http_parameters": httpParametersSchema(),
+				"input_template": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 8192),
+				},
+				"kinesis_stream_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"partition_key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+							},
+						},
+					},
+				},
+				"lambda_function_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: enum.Validate[types.PipeTargetInvocationType](),
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"message_deduplication_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 100),
+							},
+							"message_group_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 100),
+							},
+						},
+					},
+				},
+				"step_function_state_machine_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: enum.Validate[types.PipeTargetInvocationType](),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPipeTargetParameters(tfMap map[string]interface{}) *types.PipeTargetParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetParameters{}
+
+	if v, ok := tfMap["eventbridge_event_bus_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventBridgeEventBusParameters = expandPipeTargetEventBridgeEventBusParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		httpParameters := &types.PipeTargetHttpParameters{}
+
+		if v, ok := tfMap["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			httpParameters.HeaderParameters = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+			httpParameters.PathParameterValues = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			httpParameters.QueryStringParameters = flex.ExpandStringValueMap(v)
+		}
+
+		apiObject.HttpParameters = httpParameters
+	}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		kinesisStreamParameters := &types.PipeTargetKinesisStreamParameters{}
+
+		if v, ok := tfMap["partition_key"].(string); ok {
+			kinesisStreamParameters.PartitionKey = aws.String(v)
+		}
+
+		apiObject.KinesisStreamParameters = kinesisStreamParameters
+	}
+
+	if v, ok := tfMap["lambda_function_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		lambdaFunctionParameters := &types.PipeTargetLambdaFunctionParameters{}
+
+		if v, ok := tfMap["invocation_type"].(string); ok && v != "" {
+			lambdaFunctionParameters.InvocationType = types.PipeTargetInvocationType(v)
+		}
+
+		apiObject.LambdaFunctionParameters = lambdaFunctionParameters
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		sqsQueueParameters := &types.PipeTargetSqsQueueParameters{}
+
+		if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+			sqsQueueParameters.MessageDeduplicationId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+			sqsQueueParameters.MessageGroupId = aws.String(v)
+		}
+
+		apiObject.SqsQueueParameters = sqsQueueParameters
+	}
+
+	if v, ok := tfMap["step_function_state_machine_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		stateMachineParameters := &types.PipeTargetStateMachineParameters{}
+
+		if v, ok := tfMap["invocation_type"].(string); ok && v != "" {
+			stateMachineParameters.InvocationType = types.PipeTargetInvocationType(v)
+		}
+
+		apiObject.StepFunctionStateMachineParameters = stateMachineParameters
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetEventBridgeEventBusParameters(tfMap map[string]interface{}) *types.PipeTargetEventBridgeEventBusParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetEventBridgeEventBusParameters{}
+
+	if v, ok := tfMap["detail_type"].(string); ok && v != "" {
+		apiObject.DetailType = aws.String(v)
+	}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Resources = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	if v, ok := tfMap["time"].(string); ok && v != "" {
+		apiObject.Time = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPipeTargetParameters(apiObject *types.PipeTargetParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EventBridgeEventBusParameters; v != nil {
+		tfMap["eventbridge_event_bus_parameters"] = []interface{}{flattenPipeTargetEventBridgeEventBusParameters(v)}
+	}
+
+	if v := apiObject.HttpParameters; v != nil {
+		tfMap["http_parameters"] = []interface{}{flattenHTTPParameters(v.HeaderParameters, v.PathParameterValues, v.QueryStringParameters)}
+	}
+
+	if v := apiObject.InputTemplate; v != nil {
+		tfMap["input_template"] = aws.ToString(v)
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{map[string]interface{}{
+			"partition_key": aws.ToString(v.PartitionKey),
+		}}
+	}
+
+	if v := apiObject.LambdaFunctionParameters; v != nil {
+		tfMap["lambda_function_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": string(v.InvocationType),
+		}}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{map[string]interface{}{
+			"message_deduplication_id": aws.ToString(v.MessageDeduplicationId),
+			"message_group_id":         aws.ToString(v.MessageGroupId),
+		}}
+	}
+
+	if v := apiObject.StepFunctionStateMachineParameters; v != nil {
+		tfMap["step_function_state_machine_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": string(v.InvocationType),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetEventBridgeEventBusParameters(apiObject *types.PipeTargetEventBridgeEventBusParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DetailType; v != nil {
+		tfMap["detail_type"] = aws.ToString(v)
+	}
+
+	if v := apiObject.EndpointId; v != nil {
+		tfMap["endpoint_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Resources; v != nil {
+		tfMap["resources"] = flex.FlattenStringValueSet(v)
+	}
+
+	if v := apiObject.Source; v != nil {
+		tfMap["source"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Time; v != nil {
+		tfMap["time"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,113 @@
+package pipes
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitPipeCreated(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.PipeStateCreating, types.PipeStateStarting, types.PipeStateStopping),
+		Target:                    enum.Slice(types.PipeStateRunning, types.PipeStateStopped),
+		Refresh:                   statusPipe(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeUpdated(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.PipeStateUpdating, types.PipeStateStarting, types.PipeStateStopping),
+		Target:                    enum.Slice(types.PipeStateRunning, types.PipeStateStopped),
+		Refresh:                   statusPipe(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeStarted(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.PipeStateStarting, types.PipeStateStopped),
+		Target:  enum.Slice(types.PipeStateRunning),
+		Refresh: statusPipe(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeStopped(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.PipeStateStopping, types.PipeStateRunning),
+		Target:  enum.Slice(types.PipeStateStopped),
+		Refresh: statusPipe(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPipeDeleted(ctx context.Context, conn *pipes.Client, name string, timeout time.Duration) (*pipes.DescribePipeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.PipeStateDeleting),
+		Target:  []string{},
+		Refresh: statusPipe(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
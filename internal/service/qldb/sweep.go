@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
 		Name: "aws_qldb_ledger",
 		F:    sweepLedgers,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_qldb_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_stream", &resource.Sweeper{
 		Name: "aws_qldb_stream",
 		F:    sweepStreams,
 	})
@@ -0,0 +1,181 @@
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource(name="Default View Association")
+func newResourceDefaultViewAssociation(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceDefaultViewAssociation{}, nil
+}
+
+type resourceDefaultViewAssociation struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceDefaultViewAssociation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resourceexplorer2_default_view_association"
+}
+
+func (r *resourceDefaultViewAssociation) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"view_arn": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *resourceDefaultViewAssociation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceDefaultViewAssociationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client()
+
+	input := &resourceexplorer2.AssociateDefaultViewInput{
+		ViewArn: flex.StringFromFramework(ctx, data.ViewARN),
+	}
+
+	_, err := conn.AssociateDefaultView(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", data.ViewARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDefaultViewAssociation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceDefaultViewAssociationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client()
+
+	arn, err := findDefaultViewAssociation(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer Default View Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ViewARN = types.StringValue(arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDefaultViewAssociation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceDefaultViewAssociationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client()
+
+	if !new.ViewARN.Equal(old.ViewARN) {
+		input := &resourceexplorer2.AssociateDefaultViewInput{
+			ViewArn: flex.StringFromFramework(ctx, new.ViewARN),
+		}
+
+		_, err := conn.AssociateDefaultView(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", new.ViewARN.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDefaultViewAssociation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceDefaultViewAssociationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client()
+
+	tflog.Debug(ctx, "deleting Resource Explorer Default View Association", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DisassociateDefaultView(ctx, &resourceexplorer2.DisassociateDefaultViewInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer Default View Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDefaultViewAssociation) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+type resourceDefaultViewAssociationData struct {
+	ID      types.String `tfsdk:"id"`
+	ViewARN types.String `tfsdk:"view_arn"`
+}
+
+func findDefaultViewAssociation(ctx context.Context, conn *resourceexplorer2.Client) (string, error) {
+	arn, err := findDefaultViewARN(ctx, conn)
+
+	if err != nil {
+		return "", err
+	}
+
+	if arn == "" {
+		return "", tfresource.NewEmptyResultError(nil)
+	}
+
+	return arn, nil
+}
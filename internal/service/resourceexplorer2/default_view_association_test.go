@@ -0,0 +1,174 @@
+package resourceexplorer2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourceexplorer2 "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDefaultViewAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_default_view_association.test"
+	viewResourceName := "aws_resourceexplorer2_view.test.0"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultViewAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultViewAssociationConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultViewAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "view_arn", viewResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDefaultViewAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_default_view_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultViewAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultViewAssociationConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultViewAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfresourceexplorer2.ResourceDefaultViewAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDefaultViewAssociation_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_default_view_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultViewAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultViewAssociationConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultViewAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "view_arn", "aws_resourceexplorer2_view.test.0", "arn"),
+				),
+			},
+			{
+				Config: testAccDefaultViewAssociationConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultViewAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "view_arn", "aws_resourceexplorer2_view.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultViewAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceExplorer2Client()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourceexplorer2_default_view_association" {
+				continue
+			}
+
+			_, err := tfresourceexplorer2.FindDefaultViewAssociation(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resource Explorer Default View Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDefaultViewAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resource Explorer Default View Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceExplorer2Client()
+
+		_, err := tfresourceexplorer2.FindDefaultViewAssociation(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccDefaultViewAssociationConfig_basic(rName string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_resourceexplorer2_index" "test" {
+  type = "LOCAL"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourceexplorer2_view" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  depends_on = [aws_resourceexplorer2_index.test]
+}
+
+resource "aws_resourceexplorer2_default_view_association" "test" {
+  view_arn = aws_resourceexplorer2_view.test[%[2]d].arn
+}
+`, rName, index)
+}
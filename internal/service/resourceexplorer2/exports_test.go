@@ -2,8 +2,10 @@ package resourceexplorer2
 
 // Exports for use in tests only.
 var (
-	FindIndex     = findIndex
-	FindViewByARN = findViewByARN
-	ResourceIndex = newResourceIndex
-	ResourceView  = newResourceView
+	FindDefaultViewAssociation     = findDefaultViewAssociation
+	FindIndex                      = findIndex
+	FindViewByARN                  = findViewByARN
+	ResourceDefaultViewAssociation = newResourceDefaultViewAssociation
+	ResourceIndex                  = newResourceIndex
+	ResourceView                   = newResourceView
 )
@@ -244,10 +244,6 @@ func (r *resourceIndex) ImportState(ctx context.Context, request resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
-func (r *resourceIndex) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	r.SetTagsAll(ctx, request, response)
-}
-
 type resourceIndexData struct {
 	ARN      types.String   `tfsdk:"arn"`
 	ID       types.String   `tfsdk:"id"`
@@ -0,0 +1,206 @@
+package resourceexplorer2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource
+func newDataSourceSearch(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceSearch{}, nil
+}
+
+type dataSourceSearch struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceSearch) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_resourceexplorer2_search"
+}
+
+func (d *dataSourceSearch) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"query_string": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1280),
+				},
+			},
+			"resource_count": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: searchResourceCountAttributeTypes},
+				Computed:    true,
+			},
+			"resources": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: searchResourceAttributeTypes},
+				Computed:    true,
+			},
+			"view_arn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceSearch) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceSearchData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ResourceExplorer2Client()
+
+	input := &resourceexplorer2.SearchInput{
+		QueryString: flex.StringFromFramework(ctx, data.QueryString),
+	}
+
+	if !data.ViewARN.IsNull() && !data.ViewARN.IsUnknown() {
+		input.ViewArn = flex.StringFromFramework(ctx, data.ViewARN)
+	}
+
+	var count *awstypes.ResourceCount
+	var resources []awstypes.Resource
+	var viewARN string
+
+	pages := resourceexplorer2.NewSearchPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("searching Resource Explorer", err.Error())
+
+			return
+		}
+
+		if count == nil {
+			count = page.Count
+		}
+
+		if viewARN == "" {
+			viewARN = aws.ToString(page.ViewArn)
+		}
+
+		resources = append(resources, page.Resources...)
+	}
+
+	data.ID = types.StringValue(viewARN)
+	data.ResourceCount = d.flattenResourceCount(ctx, count)
+	data.Resources = d.flattenResources(ctx, resources)
+	data.ViewARN = types.StringValue(viewARN)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (d *dataSourceSearch) flattenResourceCount(ctx context.Context, apiObject *awstypes.ResourceCount) types.List {
+	elementType := types.ObjectType{AttrTypes: searchResourceCountAttributeTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elementType)
+	}
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(searchResourceCountAttributeTypes, map[string]attr.Value{
+			"complete":        flex.BoolToFramework(ctx, apiObject.Complete),
+			"total_resources": flex.Int64ToFramework(ctx, apiObject.TotalResources),
+		}),
+	})
+}
+
+func (d *dataSourceSearch) flattenResources(ctx context.Context, apiObjects []awstypes.Resource) types.List {
+	elementType := types.ObjectType{AttrTypes: searchResourceAttributeTypes}
+	var elements []attr.Value
+
+	for _, apiObject := range apiObjects {
+		elements = append(elements, types.ObjectValueMust(searchResourceAttributeTypes, map[string]attr.Value{
+			"arn":               flex.StringToFramework(ctx, apiObject.Arn),
+			"last_reported_at":  flattenTimestamp(apiObject.LastReportedAt),
+			"owning_account_id": flex.StringToFramework(ctx, apiObject.OwningAccountId),
+			"properties":        d.flattenResourceProperties(ctx, apiObject.Properties),
+			"region":            flex.StringToFramework(ctx, apiObject.Region),
+			"resource_type":     flex.StringToFramework(ctx, apiObject.ResourceType),
+			"service":           flex.StringToFramework(ctx, apiObject.Service),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elements)
+}
+
+func (d *dataSourceSearch) flattenResourceProperties(ctx context.Context, apiObjects []awstypes.ResourceProperty) types.List {
+	elementType := types.ObjectType{AttrTypes: searchResourcePropertyAttributeTypes}
+	var elements []attr.Value
+
+	for _, apiObject := range apiObjects {
+		// The property data is an arbitrary JSON document, e.g. the list of tags.
+		data := types.StringNull()
+
+		if apiObject.Data != nil {
+			if v, err := apiObject.Data.MarshalSmithyDocument(); err == nil {
+				data = types.StringValue(string(v))
+			}
+		}
+
+		elements = append(elements, types.ObjectValueMust(searchResourcePropertyAttributeTypes, map[string]attr.Value{
+			"data":             data,
+			"last_reported_at": flattenTimestamp(apiObject.LastReportedAt),
+			"name":             flex.StringToFramework(ctx, apiObject.Name),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elements)
+}
+
+func flattenTimestamp(v *time.Time) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(aws.ToTime(v).Format(time.RFC3339))
+}
+
+var (
+	searchResourceCountAttributeTypes = map[string]attr.Type{
+		"complete":        types.BoolType,
+		"total_resources": types.Int64Type,
+	}
+
+	searchResourcePropertyAttributeTypes = map[string]attr.Type{
+		"data":             types.StringType,
+		"last_reported_at": types.StringType,
+		"name":             types.StringType,
+	}
+
+	searchResourceAttributeTypes = map[string]attr.Type{
+		"arn":               types.StringType,
+		"last_reported_at":  types.StringType,
+		"owning_account_id": types.StringType,
+		"properties":        types.ListType{ElemType: types.ObjectType{AttrTypes: searchResourcePropertyAttributeTypes}},
+		"region":            types.StringType,
+		"resource_type":     types.StringType,
+		"service":           types.StringType,
+	}
+)
+
+type dataSourceSearchData struct {
+	ID            types.String `tfsdk:"id"`
+	QueryString   types.String `tfsdk:"query_string"`
+	ResourceCount types.List   `tfsdk:"resource_count"`
+	Resources     types.List   `tfsdk:"resources"`
+	ViewARN       types.String `tfsdk:"view_arn"`
+}
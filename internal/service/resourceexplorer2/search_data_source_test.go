@@ -0,0 +1,60 @@
+package resourceexplorer2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSearchDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourceexplorer2_search.test"
+	viewResourceName := "aws_resourceexplorer2_view.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSearchDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "view_arn", viewResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_count.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSearchDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourceexplorer2_index" "test" {
+  type = "LOCAL"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourceexplorer2_view" "test" {
+  name = %[1]q
+
+  depends_on = [aws_resourceexplorer2_index.test]
+}
+
+data "aws_resourceexplorer2_search" "test" {
+  query_string = "region:global"
+  view_arn     = aws_resourceexplorer2_view.test.arn
+}
+`, rName)
+}
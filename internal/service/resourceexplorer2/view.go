@@ -316,10 +316,6 @@ func (r *resourceView) ImportState(ctx context.Context, request resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
-func (r *resourceView) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	r.SetTagsAll(ctx, request, response)
-}
-
 func (r *resourceView) expandSearchFilter(ctx context.Context, tfList types.List) *awstypes.SearchFilter {
 	if tfList.IsNull() || tfList.IsUnknown() {
 		return nil
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
 		Name: "aws_secretsmanager_secret_policy",
 		F:    sweepSecretPolicies,
 	})
 
-	resource.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
 		Name: "aws_secretsmanager_secret",
 		F:    sweepSecrets,
 	})
@@ -49,7 +49,11 @@ func sweepSecretPolicies(region string) error {
 				SecretId: aws.String(name),
 			}
 
-			_, err := conn.DeleteResourcePolicyWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_secretsmanager_secret_policy", name, func() error {
+				_, err := conn.DeleteResourcePolicyWithContext(ctx, input)
+
+				return err
+			})
 			if err != nil {
 				if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
 					continue
@@ -93,7 +97,11 @@ func sweepSecrets(region string) error {
 				SecretId:                   aws.String(name),
 			}
 
-			_, err := conn.DeleteSecretWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_secretsmanager_secret", name, func() error {
+				_, err := conn.DeleteSecretWithContext(ctx, input)
+
+				return err
+			})
 			if err != nil {
 				if tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
 					continue
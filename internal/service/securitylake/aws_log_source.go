@@ -0,0 +1,253 @@
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securitylake_aws_log_source", name="AWS Log Source")
+func ResourceAWSLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAWSLogSourceCreate,
+		ReadWithoutTimeout:   resourceAWSLogSourceRead,
+		DeleteWithoutTimeout: resourceAWSLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.Region](),
+							},
+						},
+						"source_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AwsLogSourceType](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAWSLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	tfMap := d.Get("source").([]interface{})[0].(map[string]interface{})
+	sourceName := tfMap["source_name"].(string)
+	regions := flex.ExpandStringValueSet(tfMap["regions"].(*schema.Set))
+	input := &securitylake.CreateAwsLogSourceInput{}
+
+	if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+		accounts := flex.ExpandStringValueSet(v)
+		input.InputOrder = []types.Dimension{types.DimensionRegion, types.DimensionSourceType, types.DimensionMember}
+		input.EnableAllDimensions = make(map[string]map[string][]string)
+
+		for _, region := range regions {
+			input.EnableAllDimensions[region] = map[string][]string{
+				sourceName: accounts,
+			}
+		}
+	} else {
+		input.InputOrder = []types.Dimension{types.DimensionRegion, types.DimensionSourceType}
+		input.EnableTwoDimensions = make(map[string][]string)
+
+		for _, region := range regions {
+			input.EnableTwoDimensions[region] = []string{sourceName}
+		}
+	}
+
+	output, err := conn.CreateAwsLogSource(ctx, input)
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed for: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Lake AWS Log Source (%s): %s", sourceName, err)
+	}
+
+	d.SetId(sourceName)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return FindLogSourceBySourceName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake AWS Log Source (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAWSLogSourceRead(ctx, d, meta)...)
+}
+
+func resourceAWSLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	regionAccounts, err := FindLogSourceBySourceName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake AWS Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Lake AWS Log Source (%s): %s", d.Id(), err)
+	}
+
+	var accounts, regions []string
+	seen := make(map[string]bool)
+
+	for region, v := range regionAccounts {
+		regions = append(regions, region)
+
+		for _, account := range v {
+			if !seen[account] {
+				seen[account] = true
+				accounts = append(accounts, account)
+			}
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		"accounts":    flex.FlattenStringValueSet(accounts),
+		"regions":     flex.FlattenStringValueSet(regions),
+		"source_name": d.Id(),
+	}
+
+	if err := d.Set("source", []interface{}{tfMap}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAWSLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	tfMap := d.Get("source").([]interface{})[0].(map[string]interface{})
+	regions := flex.ExpandStringValueSet(tfMap["regions"].(*schema.Set))
+	input := &securitylake.DeleteAwsLogSourceInput{
+		InputOrder:           []types.Dimension{types.DimensionRegion, types.DimensionSourceType, types.DimensionMember},
+		DisableAllDimensions: make(map[string]map[string][]string),
+	}
+
+	for _, region := range regions {
+		input.DisableAllDimensions[region] = map[string][]string{
+			d.Id(): flex.ExpandStringValueSet(tfMap["accounts"].(*schema.Set)),
+		}
+	}
+
+	log.Printf("[INFO] Deleting Security Lake AWS Log Source: %s", d.Id())
+	output, err := conn.DeleteAwsLogSource(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed for: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Lake AWS Log Source (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return FindLogSourceBySourceName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake AWS Log Source (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindLogSourceBySourceName returns the accounts for which the specified log source is enabled, keyed by Region.
+// Both AWS and custom log sources are returned.
+func FindLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (map[string][]string, error) {
+	input := &securitylake.ListLogSourcesInput{}
+	regionAccounts := make(map[string][]string)
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Each element maps Region to source type to accounts.
+		for _, v := range page.RegionSourceTypesAccountsList {
+			for region, v := range v {
+				if accounts, ok := v[sourceName]; ok {
+					regionAccounts[region] = append(regionAccounts[region], accounts...)
+				}
+			}
+		}
+	}
+
+	if len(regionAccounts) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return regionAccounts, nil
+}
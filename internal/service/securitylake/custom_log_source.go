@@ -0,0 +1,172 @@
+package securitylake
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securitylake_custom_log_source", name="Custom Log Source")
+func ResourceCustomLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomLogSourceCreate,
+		ReadWithoutTimeout:   resourceCustomLogSourceRead,
+		DeleteWithoutTimeout: resourceCustomLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"custom_data_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_class": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.OcsfEventClass](),
+			},
+			"glue_crawler_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"glue_database_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"glue_invocation_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"glue_table_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_provider_access_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_provider_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"source_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceCustomLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	sourceName := d.Get("source_name").(string)
+	input := &securitylake.CreateCustomLogSourceInput{
+		CustomSourceName:      aws.String(sourceName),
+		EventClass:            types.OcsfEventClass(d.Get("event_class").(string)),
+		GlueInvocationRoleArn: aws.String(d.Get("glue_invocation_role_arn").(string)),
+		LogProviderAccountId:  aws.String(d.Get("log_provider_account_id").(string)),
+	}
+
+	output, err := conn.CreateCustomLogSource(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Lake Custom Log Source (%s): %s", sourceName, err)
+	}
+
+	d.SetId(sourceName)
+
+	// The generated resources can't be read back, so they are only set on creation.
+	d.Set("custom_data_location", output.CustomDataLocation)
+	d.Set("glue_crawler_name", output.GlueCrawlerName)
+	d.Set("glue_database_name", output.GlueDatabaseName)
+	d.Set("glue_table_name", output.GlueTableName)
+	d.Set("log_provider_access_role_arn", output.LogProviderAccessRoleArn)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return FindLogSourceBySourceName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Custom Log Source (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCustomLogSourceRead(ctx, d, meta)...)
+}
+
+func resourceCustomLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	_, err := FindLogSourceBySourceName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Custom Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Lake Custom Log Source (%s): %s", d.Id(), err)
+	}
+
+	d.Set("source_name", d.Id())
+
+	return diags
+}
+
+func resourceCustomLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	log.Printf("[INFO] Deleting Security Lake Custom Log Source: %s", d.Id())
+	_, err := conn.DeleteCustomLogSource(ctx, &securitylake.DeleteCustomLogSourceInput{
+		CustomSourceName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Lake Custom Log Source (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return FindLogSourceBySourceName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Custom Log Source (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,171 @@
+package securitylake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCustomLogSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_custom_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLakeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLogSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLogSourceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_data_location"),
+					resource.TestCheckResourceAttr(resourceName, "event_class", "DNS_ACTIVITY"),
+					resource.TestCheckResourceAttrSet(resourceName, "glue_crawler_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "glue_database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "glue_invocation_role_arn", "aws_iam_role.glue", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "glue_table_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_provider_access_role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_provider_account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "source_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"custom_data_location",
+					"event_class",
+					"glue_crawler_name",
+					"glue_database_name",
+					"glue_invocation_role_arn",
+					"glue_table_name",
+					"log_provider_access_role_arn",
+					"log_provider_account_id",
+				},
+			},
+		},
+	})
+}
+
+func testAccCustomLogSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_custom_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLakeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLogSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomLogSourceExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecuritylake.ResourceCustomLogSource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomLogSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_custom_log_source" {
+				continue
+			}
+
+			_, err := tfsecuritylake.FindLogSourceBySourceName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Lake Custom Log Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomLogSourceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Lake Custom Log Source ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient()
+
+		_, err := tfsecuritylake.FindLogSourceBySourceName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCustomLogSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(), fmt.Sprintf(`
+resource "aws_iam_role" "glue" {
+  name               = %[1]q
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "glue.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "glue" {
+  role       = aws_iam_role.glue.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+}
+
+resource "aws_securitylake_custom_log_source" "test" {
+  source_name              = %[1]q
+  event_class              = "DNS_ACTIVITY"
+  glue_invocation_role_arn = aws_iam_role.glue.arn
+  log_provider_account_id  = data.aws_caller_identity.current.account_id
+
+  depends_on = [aws_securitylake_data_lake.test, aws_iam_role_policy_attachment.glue]
+}
+`, rName))
+}
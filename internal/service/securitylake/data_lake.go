@@ -0,0 +1,510 @@
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securitylake_data_lake", name="Data Lake")
+func ResourceDataLake() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataLakeCreate,
+		ReadWithoutTimeout:   resourceDataLakeRead,
+		UpdateWithoutTimeout: resourceDataLakeUpdate,
+		DeleteWithoutTimeout: resourceDataLakeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  dataLakeEncryptionKeyS3Managed,
+						},
+						"lifecycle_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"transition": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"storage_class": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.StorageClass](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.Region](),
+						},
+						"replication_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.Region](),
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_bucket_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"meta_store_manager_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	dataLakeEncryptionKeyS3Managed = "S3_MANAGED_KEY"
+)
+
+func resourceDataLakeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	configurations := expandLakeConfigurationRequests(d.Get("configuration").(*schema.Set).List())
+	input := &securitylake.CreateDatalakeInput{
+		Configurations:          configurations,
+		MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+		Regions:                 lakeConfigurationRegions(configurations),
+	}
+
+	_, err := conn.CreateDatalake(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Lake Data Lake: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	if _, err := waitDataLakeCreated(ctx, conn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataLakeRead(ctx, d, meta)...)
+}
+
+func resourceDataLakeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	configurations, err := FindDataLakeConfigurations(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Data Lake (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenLakeConfigurationResponses(configurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataLakeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	if d.HasChange("configuration") {
+		o, n := d.GetChange("configuration")
+		configurations := expandLakeConfigurationRequests(n.(*schema.Set).List())
+
+		if removed := removedLakeConfigurationRegions(expandLakeConfigurationRequests(o.(*schema.Set).List()), configurations); len(removed) > 0 {
+			// DeleteDatalake disables Security Lake in all Regions, so the data lake is re-created in the remaining Regions.
+			log.Printf("[INFO] Deleting Security Lake Data Lake (%s) to remove Regions: %s", d.Id(), strings.Join(enum.Slice(removed...), ", "))
+			if _, err := conn.DeleteDatalake(ctx, &securitylake.DeleteDatalakeInput{}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Security Lake Data Lake (%s): deleting Regions (%s): %s", d.Id(), strings.Join(enum.Slice(removed...), ", "), err)
+			}
+
+			if _, err := waitDataLakeDeleted(ctx, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) delete: %s", d.Id(), err)
+			}
+
+			input := &securitylake.CreateDatalakeInput{
+				Configurations:          configurations,
+				MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+				Regions:                 lakeConfigurationRegions(configurations),
+			}
+
+			if _, err := conn.CreateDatalake(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Security Lake Data Lake (%s): creating: %s", d.Id(), err)
+			}
+
+			if _, err := waitDataLakeCreated(ctx, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) create: %s", d.Id(), err)
+			}
+
+			return append(diags, resourceDataLakeRead(ctx, d, meta)...)
+		}
+
+		input := &securitylake.UpdateDatalakeInput{
+			Configurations: configurations,
+		}
+
+		_, err := conn.UpdateDatalake(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Security Lake Data Lake (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataLakeRead(ctx, d, meta)...)
+}
+
+func resourceDataLakeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	log.Printf("[INFO] Deleting Security Lake Data Lake: %s", d.Id())
+	_, err := conn.DeleteDatalake(ctx, &securitylake.DeleteDatalakeInput{})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Lake Data Lake (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Data Lake (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDataLakeConfigurations(ctx context.Context, conn *securitylake.Client) (map[string]types.LakeConfigurationResponse, error) {
+	input := &securitylake.GetDatalakeInput{}
+
+	output, err := conn.GetDatalake(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Configurations) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Configurations, nil
+}
+
+// statusDataLake returns the least advanced status of the data lake's per-Region configurations.
+func statusDataLake(ctx context.Context, conn *securitylake.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataLakeConfigurations(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := types.SettingsStatusCompleted
+		var failures []string
+
+		for region, configuration := range output {
+			switch configuration.Status {
+			case types.SettingsStatusFailed:
+				status = types.SettingsStatusFailed
+
+				if v := configuration.UpdateStatus; v != nil && v.LastUpdateFailure != nil {
+					failures = append(failures, fmt.Sprintf("%s: %s", region, aws.ToString(v.LastUpdateFailure.Reason)))
+				}
+			case types.SettingsStatusInitialized, types.SettingsStatusPending:
+				if status != types.SettingsStatusFailed {
+					status = configuration.Status
+				}
+			}
+		}
+
+		if len(failures) > 0 {
+			return output, string(status), errors.New(strings.Join(failures, "; "))
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, timeout time.Duration) (map[string]types.LakeConfigurationResponse, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.SettingsStatusInitialized, types.SettingsStatusPending),
+		Target:                    enum.Slice(types.SettingsStatusCompleted),
+		Refresh:                   statusDataLake(ctx, conn),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(map[string]types.LakeConfigurationResponse); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, timeout time.Duration) (map[string]types.LakeConfigurationResponse, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.SettingsStatusInitialized, types.SettingsStatusPending),
+		Target:                    enum.Slice(types.SettingsStatusCompleted),
+		Refresh:                   statusDataLake(ctx, conn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(map[string]types.LakeConfigurationResponse); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, timeout time.Duration) (map[string]types.LakeConfigurationResponse, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.SettingsStatusInitialized, types.SettingsStatusPending, types.SettingsStatusCompleted),
+		Target:  []string{},
+		Refresh: statusDataLake(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(map[string]types.LakeConfigurationResponse); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandLakeConfigurationRequests(tfList []interface{}) map[string]types.LakeConfigurationRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]types.LakeConfigurationRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.LakeConfigurationRequest{}
+
+		if v, ok := tfMap["encryption_key"].(string); ok && v != "" {
+			apiObject.EncryptionKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["lifecycle_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["transition"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.RetentionSettings = expandRetentionSettings(v.List())
+			}
+		}
+
+		if v, ok := tfMap["replication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.ReplicationDestinationRegions = flex.ExpandStringyValueSet[types.Region](v)
+			}
+
+			if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+				apiObject.ReplicationRoleArn = aws.String(v)
+			}
+		}
+
+		apiObjects[tfMap["region"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandRetentionSettings(tfList []interface{}) []types.RetentionSetting {
+	var apiObjects []types.RetentionSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.RetentionSetting{}
+
+		if v, ok := tfMap["days"].(int); ok && v != 0 {
+			apiObject.RetentionPeriod = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			apiObject.StorageClass = types.StorageClass(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func lakeConfigurationRegions(apiObjects map[string]types.LakeConfigurationRequest) []types.Region {
+	regions := make([]types.Region, 0, len(apiObjects))
+
+	for region := range apiObjects {
+		regions = append(regions, types.Region(region))
+	}
+
+	return regions
+}
+
+// removedLakeConfigurationRegions returns the Regions configured in o but not in n.
+func removedLakeConfigurationRegions(o, n map[string]types.LakeConfigurationRequest) []types.Region {
+	var regions []types.Region
+
+	for region := range o {
+		if _, ok := n[region]; !ok {
+			regions = append(regions, types.Region(region))
+		}
+	}
+
+	return regions
+}
+
+func flattenLakeConfigurationResponses(apiObjects map[string]types.LakeConfigurationResponse) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for region, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"encryption_key": aws.ToString(apiObject.EncryptionKey),
+			"region":         region,
+			"s3_bucket_arn":  aws.ToString(apiObject.S3BucketArn),
+			"status":         string(apiObject.Status),
+		}
+
+		if v := apiObject.RetentionSettings; len(v) > 0 {
+			tfMap["lifecycle_configuration"] = []interface{}{map[string]interface{}{
+				"transition": flattenRetentionSettings(v),
+			}}
+		}
+
+		if len(apiObject.ReplicationDestinationRegions) > 0 || apiObject.ReplicationRoleArn != nil {
+			tfMap["replication_configuration"] = []interface{}{map[string]interface{}{
+				"regions":  flex.FlattenStringValueSet(enum.Slice(apiObject.ReplicationDestinationRegions...)),
+				"role_arn": aws.ToString(apiObject.ReplicationRoleArn),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenRetentionSettings(apiObjects []types.RetentionSetting) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"days":          aws.ToInt32(apiObject.RetentionPeriod),
+			"storage_class": string(apiObject.StorageClass),
+		})
+	}
+
+	return tfList
+}
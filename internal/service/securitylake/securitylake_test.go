@@ -0,0 +1,84 @@
+package securitylake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Security Lake data lakes are account-level singletons, and all other resources depend on a data lake.
+func TestAccSecurityLake_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"DataLake": {
+			"basic":        testAccDataLake_basic,
+			"disappears":   testAccDataLake_disappears,
+			"update":       testAccDataLake_update,
+			"removeRegion": testAccDataLake_removeRegion,
+		},
+		"AWSLogSource": {
+			"basic":      testAccAWSLogSource_basic,
+			"disappears": testAccAWSLogSource_disappears,
+		},
+		"CustomLogSource": {
+			"basic":      testAccCustomLogSource_basic,
+			"disappears": testAccCustomLogSource_disappears,
+		},
+		"Subscriber": {
+			"basic":      testAccSubscriber_basic,
+			"disappears": testAccSubscriber_disappears,
+			"update":     testAccSubscriber_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient()
+
+	input := &securitylake.ListSubscribersInput{}
+	_, err := conn.ListSubscribers(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccDataLakeConfig_base() string {
+	return `
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "meta_store_manager" {
+  name               = "AmazonSecurityLakeMetaStoreManager"
+  path               = "/service-role/"
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "lambda.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "meta_store_manager" {
+  role       = aws_iam_role.meta_store_manager.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonSecurityLakeMetastoreManager"
+}
+`
+}
@@ -24,7 +24,28 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAWSLogSource,
+			TypeName: "aws_securitylake_aws_log_source",
+			Name:     "AWS Log Source",
+		},
+		{
+			Factory:  ResourceCustomLogSource,
+			TypeName: "aws_securitylake_custom_log_source",
+			Name:     "Custom Log Source",
+		},
+		{
+			Factory:  ResourceDataLake,
+			TypeName: "aws_securitylake_data_lake",
+			Name:     "Data Lake",
+		},
+		{
+			Factory:  ResourceSubscriber,
+			TypeName: "aws_securitylake_subscriber",
+			Name:     "Subscriber",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,304 @@
+package securitylake
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_securitylake_subscriber", name="Subscriber")
+func ResourceSubscriber() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSubscriberCreate,
+		ReadWithoutTimeout:   resourceSubscriberRead,
+		UpdateWithoutTimeout: resourceSubscriberUpdate,
+		DeleteWithoutTimeout: resourceSubscriberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.AccessType](),
+				},
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_share_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sns_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_type": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_source_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.AwsLogSourceType](),
+						},
+						"custom_source_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+					},
+				},
+			},
+			"subscriber_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subscriber_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subscription_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSubscriberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	name := d.Get("subscriber_name").(string)
+	input := &securitylake.CreateSubscriberInput{
+		AccountId:      aws.String(d.Get("account_id").(string)),
+		ExternalId:     aws.String(d.Get("external_id").(string)),
+		SourceTypes:    expandSourceTypes(d.Get("source_type").(*schema.Set).List()),
+		SubscriberName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("access_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccessTypes = flex.ExpandStringyValueSet[types.AccessType](v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subscriber_description"); ok {
+		input.SubscriberDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Lake Subscriber (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.SubscriptionId))
+
+	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return FindSubscriberByID(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Lake Subscriber (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSubscriberRead(ctx, d, meta)...)
+}
+
+func resourceSubscriberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	subscriber, err := FindSubscriberByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Subscriber (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_types", enum.Slice(subscriber.AccessTypes...))
+	d.Set("account_id", subscriber.AccountId)
+	d.Set("external_id", subscriber.ExternalId)
+	d.Set("resource_share_arn", subscriber.ResourceShareArn)
+	d.Set("resource_share_name", subscriber.ResourceShareName)
+	d.Set("role_arn", subscriber.RoleArn)
+	d.Set("s3_bucket_arn", subscriber.S3BucketArn)
+	d.Set("sns_arn", subscriber.SnsArn)
+	if err := d.Set("source_type", flattenSourceTypes(subscriber.SourceTypes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_type: %s", err)
+	}
+	d.Set("subscriber_description", subscriber.SubscriberDescription)
+	d.Set("subscriber_name", subscriber.SubscriberName)
+	d.Set("subscription_status", subscriber.SubscriptionStatus)
+
+	return diags
+}
+
+func resourceSubscriberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	input := &securitylake.UpdateSubscriberInput{
+		ExternalId:            aws.String(d.Get("external_id").(string)),
+		Id:                    aws.String(d.Id()),
+		SourceTypes:           expandSourceTypes(d.Get("source_type").(*schema.Set).List()),
+		SubscriberDescription: aws.String(d.Get("subscriber_description").(string)),
+		SubscriberName:        aws.String(d.Get("subscriber_name").(string)),
+	}
+
+	_, err := conn.UpdateSubscriber(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSubscriberRead(ctx, d, meta)...)
+}
+
+func resourceSubscriberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	log.Printf("[INFO] Deleting Security Lake Subscriber: %s", d.Id())
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Lake Subscriber (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*types.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Subscriber.SubscriptionStatus; status == types.SubscriptionStatusDeactivated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Subscriber, nil
+}
+
+func expandSourceTypes(tfList []interface{}) []types.SourceType {
+	var apiObjects []types.SourceType
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["aws_source_type"].(string); ok && v != "" {
+			apiObjects = append(apiObjects, &types.SourceTypeMemberAwsSourceType{
+				Value: types.AwsLogSourceType(v),
+			})
+		}
+
+		if v, ok := tfMap["custom_source_type"].(string); ok && v != "" {
+			apiObjects = append(apiObjects, &types.SourceTypeMemberCustomSourceType{
+				Value: v,
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenSourceTypes(apiObjects []types.SourceType) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *types.SourceTypeMemberAwsSourceType:
+			tfList = append(tfList, map[string]interface{}{
+				"aws_source_type": string(v.Value),
+			})
+		case *types.SourceTypeMemberCustomSourceType:
+			tfList = append(tfList, map[string]interface{}{
+				"custom_source_type": v.Value,
+			})
+		}
+	}
+
+	return tfList
+}
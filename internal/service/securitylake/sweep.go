@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package securitylake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_securitylake_subscriber", &resource.Sweeper{
+		Name: "aws_securitylake_subscriber",
+		F:    sweepSubscribers,
+	})
+}
+
+func sweepSubscribers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SecurityLakeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := securitylake.NewListSubscribersPaginator(conn, &securitylake.ListSubscribersInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Security Lake Subscriber sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing Security Lake Subscribers for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range page.Subscribers {
+			r := ResourceSubscriber()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.SubscriptionId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Security Lake Subscribers for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -19,31 +19,31 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_budget_resource_association",
 		Dependencies: []string{},
 		F:            sweepBudgetResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
 		Name:         "aws_servicecatalog_constraint",
 		Dependencies: []string{},
 		F:            sweepConstraints,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_principal_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepPrincipalPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_product_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepProductPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
 		Name: "aws_servicecatalog_product",
 		Dependencies: []string{
 			"aws_servicecatalog_provisioning_artifact",
@@ -51,31 +51,31 @@ func init() {
 		F: sweepProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioned_product",
 		Dependencies: []string{},
 		F:            sweepProvisionedProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioning_artifact",
 		Dependencies: []string{},
 		F:            sweepProvisioningArtifacts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
 		Name:         "aws_servicecatalog_service_action",
 		Dependencies: []string{},
 		F:            sweepServiceActions,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_tag_option_resource_association",
 		Dependencies: []string{},
 		F:            sweepTagOptionResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
 		Name:         "aws_servicecatalog_tag_option",
 		Dependencies: []string{},
 		F:            sweepTagOptions,
@@ -17,22 +17,22 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeDomain) },
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeEmailAddress) },
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
@@ -63,8 +63,12 @@ func sweepConfigurationSets(region string) error {
 			name := aws.StringValue(configurationSet.Name)
 
 			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
-			_, err := conn.DeleteConfigurationSetWithContext(ctx, &ses.DeleteConfigurationSetInput{
-				ConfigurationSetName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_ses_configuration_set", name, func() error {
+				_, err := conn.DeleteConfigurationSetWithContext(ctx, &ses.DeleteConfigurationSetInput{
+					ConfigurationSetName: aws.String(name),
+				})
+
+				return err
 			})
 			if tfawserr.ErrCodeEquals(err, ses.ErrCodeConfigurationSetDoesNotExistException) {
 				continue
@@ -96,6 +100,10 @@ func sweepIdentities(region, identityType string) error {
 	input := &ses.ListIdentitiesInput{
 		IdentityType: aws.String(identityType),
 	}
+	resourceType := "aws_ses_email_identity"
+	if identityType == ses.IdentityTypeDomain {
+		resourceType = "aws_ses_domain_identity"
+	}
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListIdentitiesPagesWithContext(ctx, input, func(page *ses.ListIdentitiesOutput, lastPage bool) bool {
@@ -107,8 +115,12 @@ func sweepIdentities(region, identityType string) error {
 			identity := aws.StringValue(identity)
 
 			log.Printf("[INFO] Deleting SES Identity: %s", identity)
-			_, err = conn.DeleteIdentityWithContext(ctx, &ses.DeleteIdentityInput{
-				Identity: aws.String(identity),
+			err = sweep.DeleteFunc(ctx, client, resourceType, identity, func() error {
+				_, err := conn.DeleteIdentityWithContext(ctx, &ses.DeleteIdentityInput{
+					Identity: aws.String(identity),
+				})
+
+				return err
 			})
 			if err != nil {
 				sweeperErr := fmt.Errorf("deleting SES Identity (%s): %w", identity, err)
@@ -139,16 +151,18 @@ func sweepReceiptRuleSets(region string) error {
 	}
 	conn := client.(*conns.AWSClient).SESConn()
 
-	// You cannot delete the receipt rule set that is currently active.
-	// Setting the name of the receipt rule set to make active to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSetWithContext(ctx, &ses.SetActiveReceiptRuleSetInput{})
+	output, err := conn.DescribeActiveReceiptRuleSetWithContext(ctx, &ses.DescribeActiveReceiptRuleSetInput{})
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("disabling any currently active SES Receipt Rule Set: %w", err)
+		return fmt.Errorf("describing active SES Receipt Rule Set: %w", err)
+	}
+
+	var activeName string
+	if output.Metadata != nil {
+		activeName = aws.StringValue(output.Metadata.Name)
 	}
 
 	input := &ses.ListReceiptRuleSetsInput{}
@@ -169,8 +183,21 @@ func sweepReceiptRuleSets(region string) error {
 			name := aws.StringValue(ruleSet.Name)
 
 			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
-			_, err := conn.DeleteReceiptRuleSetWithContext(ctx, &ses.DeleteReceiptRuleSetInput{
-				RuleSetName: aws.String(name),
+			err := sweep.DeleteFunc(ctx, client, "aws_ses_receipt_rule_set", name, func() error {
+				// You cannot delete the receipt rule set that is currently active.
+				// Setting the name of the receipt rule set to make active to null disables all email receiving.
+				if name == activeName {
+					log.Printf("[INFO] Disabling active SES Receipt Rule Set: %s", name)
+					if _, err := conn.SetActiveReceiptRuleSetWithContext(ctx, &ses.SetActiveReceiptRuleSetInput{}); err != nil {
+						return fmt.Errorf("disabling active SES Receipt Rule Set: %w", err)
+					}
+				}
+
+				_, err := conn.DeleteReceiptRuleSetWithContext(ctx, &ses.DeleteReceiptRuleSetInput{
+					RuleSetName: aws.String(name),
+				})
+
+				return err
 			})
 			if tfawserr.ErrCodeEquals(err, ses.ErrCodeRuleSetDoesNotExistException) {
 				continue
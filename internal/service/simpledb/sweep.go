@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_simpledb_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_simpledb_domain", &resource.Sweeper{
 		Name: "aws_simpledb_domain",
 		F:    sweepDomains,
 	})
@@ -0,0 +1,287 @@
+package sqs_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestQueue_mock(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping mock test in short mode: SQS queue attribute propagation waiters take several minutes")
+	}
+
+	server, queues := newMockQueueServer(t)
+	rName := "mock-queue"
+	resourceName := "aws_sqs_queue.test"
+	endpoints := map[string]string{"sqs": server.URL}
+
+	acctest.MockUnitTest(t, endpoints, resource.TestCase{
+		CheckDestroy: testAccCheckMockQueueDestroy(queues),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_mock(rName, 30, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", fmt.Sprintf("arn:aws:sqs:%s:%s:%s", acctest.MockRegion, acctest.MockAccountID, rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "url", fmt.Sprintf("%s/%s/%s", server.URL, acctest.MockAccountID, rName)),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "30"),
+				),
+			},
+			{
+				Config: testAccQueueConfig_mock(rName, 60, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
+type mockQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type mockQueueStore struct {
+	sync.Mutex
+	queues map[string]*mockQueue
+}
+
+// newMockQueueServer returns a mock SQS (Query protocol) endpoint that implements the queue and tagging API operations in memory.
+func newMockQueueServer(t *testing.T) (*acctest.MockServer, *mockQueueStore) {
+	t.Helper()
+
+	server := acctest.NewMockServer(t)
+	store := &mockQueueStore{
+		queues: make(map[string]*mockQueue),
+	}
+
+	nonExistentQueue := func() *acctest.MockResponse {
+		return acctest.MockQueryErrorResponse(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+
+	server.HandleOperation("CreateQueue", func(r *acctest.MockRequest) *acctest.MockResponse {
+		name := r.Params.Get("QueueName")
+		queueURL := fmt.Sprintf("%s/%s/%s", server.URL, acctest.MockAccountID, name)
+
+		store.Lock()
+		defer store.Unlock()
+
+		if _, ok := store.queues[queueURL]; !ok {
+			queue := &mockQueue{
+				attributes: map[string]string{
+					"DelaySeconds":                  "0",
+					"MaximumMessageSize":            "262144",
+					"MessageRetentionPeriod":        "345600",
+					"QueueArn":                      fmt.Sprintf("arn:aws:sqs:%s:%s:%s", acctest.MockRegion, acctest.MockAccountID, name),
+					"ReceiveMessageWaitTimeSeconds": "0",
+					"SqsManagedSseEnabled":          "true",
+					"VisibilityTimeout":             "30",
+				},
+				tags: make(map[string]string),
+			}
+
+			for k, v := range mockQueryMap(r.Params, "Attribute", "Name", "Value") {
+				queue.attributes[k] = v
+			}
+			for k, v := range mockQueryMap(r.Params, "Tag", "Key", "Value") {
+				queue.tags[k] = v
+			}
+
+			store.queues[queueURL] = queue
+		}
+
+		return acctest.MockQueryResponse("CreateQueue", fmt.Sprintf("<QueueUrl>%s</QueueUrl>", mockXMLEscape(queueURL)))
+	})
+
+	server.HandleOperation("GetQueueAttributes", func(r *acctest.MockRequest) *acctest.MockResponse {
+		store.Lock()
+		defer store.Unlock()
+
+		queue, ok := store.queues[r.Params.Get("QueueUrl")]
+
+		if !ok {
+			return nonExistentQueue()
+		}
+
+		return acctest.MockQueryResponse("GetQueueAttributes", mockQueryMapXML(queue.attributes, "Attribute", "Name", "Value"))
+	})
+
+	server.HandleOperation("SetQueueAttributes", func(r *acctest.MockRequest) *acctest.MockResponse {
+		store.Lock()
+		defer store.Unlock()
+
+		queue, ok := store.queues[r.Params.Get("QueueUrl")]
+
+		if !ok {
+			return nonExistentQueue()
+		}
+
+		for k, v := range mockQueryMap(r.Params, "Attribute", "Name", "Value") {
+			queue.attributes[k] = v
+		}
+
+		return acctest.MockQueryResponse("SetQueueAttributes", "")
+	})
+
+	server.HandleOperation("DeleteQueue", func(r *acctest.MockRequest) *acctest.MockResponse {
+		store.Lock()
+		defer store.Unlock()
+
+		queueURL := r.Params.Get("QueueUrl")
+
+		if _, ok := store.queues[queueURL]; !ok {
+			return nonExistentQueue()
+		}
+
+		delete(store.queues, queueURL)
+
+		return acctest.MockQueryResponse("DeleteQueue", "")
+	})
+
+	server.HandleOperation("ListQueueTags", func(r *acctest.MockRequest) *acctest.MockResponse {
+		store.Lock()
+		defer store.Unlock()
+
+		queue, ok := store.queues[r.Params.Get("QueueUrl")]
+
+		if !ok {
+			return nonExistentQueue()
+		}
+
+		return acctest.MockQueryResponse("ListQueueTags", mockQueryMapXML(queue.tags, "Tag", "Key", "Value"))
+	})
+
+	server.HandleOperation("TagQueue", func(r *acctest.MockRequest) *acctest.MockResponse {
+		store.Lock()
+		defer store.Unlock()
+
+		queue, ok := store.queues[r.Params.Get("QueueUrl")]
+
+		if !ok {
+			return nonExistentQueue()
+		}
+
+		for k, v := range mockQueryMap(r.Params, "Tag", "Key", "Value") {
+			queue.tags[k] = v
+		}
+
+		return acctest.MockQueryResponse("TagQueue", "")
+	})
+
+	server.HandleOperation("UntagQueue", func(r *acctest.MockRequest) *acctest.MockResponse {
+		store.Lock()
+		defer store.Unlock()
+
+		queue, ok := store.queues[r.Params.Get("QueueUrl")]
+
+		if !ok {
+			return nonExistentQueue()
+		}
+
+		for i := 1; ; i++ {
+			key := r.Params.Get(fmt.Sprintf("TagKey.%d", i))
+
+			if key == "" {
+				break
+			}
+
+			delete(queue.tags, key)
+		}
+
+		return acctest.MockQueryResponse("UntagQueue", "")
+	})
+
+	return server, store
+}
+
+// mockQueryMap decodes a flattened Query protocol map parameter, e.g. Attribute.1.Name=DelaySeconds&Attribute.1.Value=0.
+func mockQueryMap(params url.Values, prefix, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		key := params.Get(fmt.Sprintf("%s.%d.%s", prefix, i, keyName))
+
+		if key == "" {
+			break
+		}
+
+		m[key] = params.Get(fmt.Sprintf("%s.%d.%s", prefix, i, valueName))
+	}
+
+	return m
+}
+
+// mockQueryMapXML encodes a flattened Query protocol map response element.
+func mockQueryMapXML(m map[string]string, elementName, keyName, valueName string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var str strings.Builder
+
+	for _, k := range keys {
+		fmt.Fprintf(&str, "<%[1]s><%[2]s>%[4]s</%[2]s><%[3]s>%[5]s</%[3]s></%[1]s>", elementName, keyName, valueName, mockXMLEscape(k), mockXMLEscape(m[k]))
+	}
+
+	return str.String()
+}
+
+func mockXMLEscape(s string) string {
+	var str strings.Builder
+
+	xml.EscapeText(&str, []byte(s)) //nolint:errcheck
+
+	return str.String()
+}
+
+func testAccCheckMockQueueDestroy(store *mockQueueStore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue" {
+				continue
+			}
+
+			store.Lock()
+			_, ok := store.queues[rs.Primary.ID]
+			store.Unlock()
+
+			if ok {
+				return fmt.Errorf("SQS Queue %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccQueueConfig_mock(rName string, visibilityTimeoutSeconds int, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                       = %[1]q
+  visibility_timeout_seconds = %[2]d
+
+  tags = {
+    key1 = %[3]q
+  }
+}
+`, rName, visibilityTimeoutSeconds, tagValue)
+}
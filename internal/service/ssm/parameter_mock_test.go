@@ -0,0 +1,373 @@
+package ssm_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestParameter_mock(t *testing.T) {
+	t.Parallel()
+
+	server, parameters := newMockParameterServer(t)
+	name := "/mock/parameter"
+	resourceName := "aws_ssm_parameter.test"
+	endpoints := map[string]string{"ssm": server.URL}
+
+	acctest.MockUnitTest(t, endpoints, resource.TestCase{
+		CheckDestroy: testAccCheckMockParameterDestroy(parameters),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_mock(name, "value1", "description1", "tag1value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", fmt.Sprintf("arn:aws:ssm:%s:%s:parameter%s", acctest.MockRegion, acctest.MockAccountID, name)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "tag1value"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "type", "String"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+			{
+				Config: testAccParameterConfig_mock(name, "value2", "description2", "tag1updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "tag1updated"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					testAccCheckMockParameterRequests(server, "PutParameter", 2),
+					testAccCheckMockParameterRequests(server, "AddTagsToResource", 1),
+				),
+			},
+		},
+	})
+}
+
+type mockParameter struct {
+	AllowedPattern string            `json:",omitempty"`
+	DataType       string            `json:",omitempty"`
+	Description    string            `json:",omitempty"`
+	KeyId          string            `json:",omitempty"`
+	Name           string            `json:",omitempty"`
+	Tags           map[string]string `json:"-"`
+	Tier           string            `json:",omitempty"`
+	Type           string            `json:",omitempty"`
+	Value          string            `json:",omitempty"`
+	Version        int64             `json:",omitempty"`
+}
+
+func (p *mockParameter) arn() string {
+	return fmt.Sprintf("arn:aws:ssm:%s:%s:parameter/%s", acctest.MockRegion, acctest.MockAccountID, strings.TrimPrefix(p.Name, "/"))
+}
+
+type mockParameterStore struct {
+	sync.Mutex
+	parameters map[string]*mockParameter
+}
+
+type mockTag struct {
+	Key   string
+	Value string
+}
+
+// newMockParameterServer returns a mock SSM endpoint that implements the parameter and tagging API operations in memory.
+func newMockParameterServer(t *testing.T) (*acctest.MockServer, *mockParameterStore) {
+	t.Helper()
+
+	server := acctest.NewMockServer(t)
+	store := &mockParameterStore{
+		parameters: make(map[string]*mockParameter),
+	}
+
+	parameterNotFound := func(name string) *acctest.MockResponse {
+		return acctest.MockJSONErrorResponse(http.StatusBadRequest, "ParameterNotFound", fmt.Sprintf("Parameter %s not found.", name))
+	}
+	validationException := func(err error) *acctest.MockResponse {
+		return acctest.MockJSONErrorResponse(http.StatusBadRequest, "ValidationException", err.Error())
+	}
+
+	server.HandleOperation("PutParameter", func(r *acctest.MockRequest) *acctest.MockResponse {
+		var input struct {
+			mockParameter
+			Overwrite bool
+			Tags      []mockTag
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return validationException(err)
+		}
+
+		store.Lock()
+		defer store.Unlock()
+
+		parameter, ok := store.parameters[input.Name]
+
+		if ok && !input.Overwrite {
+			return acctest.MockJSONErrorResponse(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+
+		if !ok {
+			parameter = &mockParameter{
+				DataType: "text",
+				Name:     input.Name,
+				Tags:     make(map[string]string),
+				Tier:     "Standard",
+			}
+			store.parameters[input.Name] = parameter
+		}
+
+		parameter.AllowedPattern = input.AllowedPattern
+		parameter.Type = input.Type
+		parameter.Value = input.Value
+		parameter.Version++
+		if input.DataType != "" {
+			parameter.DataType = input.DataType
+		}
+		if input.Description != "" {
+			parameter.Description = input.Description
+		}
+		if input.KeyId != "" {
+			parameter.KeyId = input.KeyId
+		}
+		if input.Tier != "" {
+			parameter.Tier = input.Tier
+		}
+		for _, tag := range input.Tags {
+			parameter.Tags[tag.Key] = tag.Value
+		}
+
+		return acctest.MockJSONResponse(map[string]any{
+			"Tier":    parameter.Tier,
+			"Version": parameter.Version,
+		})
+	})
+
+	server.HandleOperation("GetParameter", func(r *acctest.MockRequest) *acctest.MockResponse {
+		var input struct {
+			Name string
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return validationException(err)
+		}
+
+		store.Lock()
+		defer store.Unlock()
+
+		parameter, ok := store.parameters[input.Name]
+
+		if !ok {
+			return parameterNotFound(input.Name)
+		}
+
+		return acctest.MockJSONResponse(map[string]any{
+			"Parameter": map[string]any{
+				"ARN":      parameter.arn(),
+				"DataType": parameter.DataType,
+				"Name":     parameter.Name,
+				"Type":     parameter.Type,
+				"Value":    parameter.Value,
+				"Version":  parameter.Version,
+			},
+		})
+	})
+
+	server.HandleOperation("DescribeParameters", func(r *acctest.MockRequest) *acctest.MockResponse {
+		var input struct {
+			ParameterFilters []struct {
+				Key    string
+				Values []string
+			}
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return validationException(err)
+		}
+
+		store.Lock()
+		defer store.Unlock()
+
+		parameters := make([]mockParameter, 0)
+
+		for _, filter := range input.ParameterFilters {
+			if filter.Key != "Name" {
+				continue
+			}
+
+			for _, name := range filter.Values {
+				if parameter, ok := store.parameters[name]; ok {
+					v := *parameter
+					v.Value = ""
+					parameters = append(parameters, v)
+				}
+			}
+		}
+
+		return acctest.MockJSONResponse(map[string]any{
+			"Parameters": parameters,
+		})
+	})
+
+	server.HandleOperation("DeleteParameter", func(r *acctest.MockRequest) *acctest.MockResponse {
+		var input struct {
+			Name string
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return validationException(err)
+		}
+
+		store.Lock()
+		defer store.Unlock()
+
+		if _, ok := store.parameters[input.Name]; !ok {
+			return parameterNotFound(input.Name)
+		}
+
+		delete(store.parameters, input.Name)
+
+		return acctest.MockJSONResponse(map[string]any{})
+	})
+
+	server.HandleOperation("ListTagsForResource", func(r *acctest.MockRequest) *acctest.MockResponse {
+		var input struct {
+			ResourceId string
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return validationException(err)
+		}
+
+		store.Lock()
+		defer store.Unlock()
+
+		parameter, ok := store.parameters[input.ResourceId]
+
+		if !ok {
+			return acctest.MockJSONErrorResponse(http.StatusBadRequest, "InvalidResourceId", "The resource ID is not valid.")
+		}
+
+		tags := make([]mockTag, 0, len(parameter.Tags))
+		for k, v := range parameter.Tags {
+			tags = append(tags, mockTag{Key: k, Value: v})
+		}
+
+		return acctest.MockJSONResponse(map[string]any{
+			"TagList": tags,
+		})
+	})
+
+	server.HandleOperation("AddTagsToResource", func(r *acctest.MockRequest) *acctest.MockResponse {
+		var input struct {
+			ResourceId string
+			Tags       []mockTag
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return validationException(err)
+		}
+
+		store.Lock()
+		defer store.Unlock()
+
+		parameter, ok := store.parameters[input.ResourceId]
+
+		if !ok {
+			return acctest.MockJSONErrorResponse(http.StatusBadRequest, "InvalidResourceId", "The resource ID is not valid.")
+		}
+
+		for _, tag := range input.Tags {
+			parameter.Tags[tag.Key] = tag.Value
+		}
+
+		return acctest.MockJSONResponse(map[string]any{})
+	})
+
+	server.HandleOperation("RemoveTagsFromResource", func(r *acctest.MockRequest) *acctest.MockResponse {
+		var input struct {
+			ResourceId string
+			TagKeys    []string
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return validationException(err)
+		}
+
+		store.Lock()
+		defer store.Unlock()
+
+		parameter, ok := store.parameters[input.ResourceId]
+
+		if !ok {
+			return acctest.MockJSONErrorResponse(http.StatusBadRequest, "InvalidResourceId", "The resource ID is not valid.")
+		}
+
+		for _, key := range input.TagKeys {
+			delete(parameter.Tags, key)
+		}
+
+		return acctest.MockJSONResponse(map[string]any{})
+	})
+
+	return server, store
+}
+
+func testAccCheckMockParameterDestroy(store *mockParameterStore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameter" {
+				continue
+			}
+
+			store.Lock()
+			_, ok := store.parameters[rs.Primary.ID]
+			store.Unlock()
+
+			if ok {
+				return fmt.Errorf("SSM Parameter %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMockParameterRequests(server *acctest.MockServer, operation string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(server.Requests(operation)); got != want {
+			return fmt.Errorf("got %d %s requests, expected %d", got, operation, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccParameterConfig_mock(name, value, description, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name        = %[1]q
+  type        = "String"
+  value       = %[2]q
+  description = %[3]q
+
+  tags = {
+    key1 = %[4]q
+  }
+}
+`, name, value, description, tagValue)
+}
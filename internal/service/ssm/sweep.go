@@ -24,17 +24,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
 		Name: "aws_ssm_default_patch_baseline",
 		F:    sweepResourceDefaultPatchBaselines,
 	})
 
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
 		Name: "aws_ssm_patch_baseline",
 		F:    sweepResourcePatchBaselines,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
@@ -147,7 +147,11 @@ func sweepMaintenanceWindows(region string) error {
 
 			log.Printf("[INFO] Deleting SSM Maintenance Window: %s", id)
 
-			_, err := conn.DeleteMaintenanceWindowWithContext(ctx, input)
+			err := sweep.DeleteFunc(ctx, client, "aws_ssm_maintenance_window", id, func() error {
+				_, err := conn.DeleteMaintenanceWindowWithContext(ctx, input)
+
+				return err
+			})
 
 			if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDoesNotExistException) {
 				continue
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_swf_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_swf_domain", &resource.Sweeper{
 		Name: "aws_swf_domain",
 		F:    sweepDomains,
 	})
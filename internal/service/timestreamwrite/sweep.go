@@ -15,13 +15,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
 		Name:         "aws_timestreamwrite_database",
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
 
-	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
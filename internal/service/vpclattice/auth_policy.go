@@ -0,0 +1,159 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_auth_policy", name="AuthPolicy")
+func ResourceAuthPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAuthPolicyPut,
+		ReadWithoutTimeout:   resourceAuthPolicyRead,
+		UpdateWithoutTimeout: resourceAuthPolicyPut,
+		DeleteWithoutTimeout: resourceAuthPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				ValidateFunc:          verify.ValidIAMPolicyJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"resource_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameAuthPolicy = "Auth Policy"
+)
+
+func resourceAuthPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	resourceID := d.Get("resource_identifier").(string)
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	in := &vpclattice.PutAuthPolicyInput{
+		Policy:             aws.String(policy),
+		ResourceIdentifier: aws.String(resourceID),
+	}
+
+	_, err = conn.PutAuthPolicy(ctx, in)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameAuthPolicy, resourceID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(resourceID)
+	}
+
+	return resourceAuthPolicyRead(ctx, d, meta)
+}
+
+func resourceAuthPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	out, err := findAuthPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice AuthPolicy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameAuthPolicy, d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.ToString(out.Policy))
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameAuthPolicy, d.Id(), err)
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("resource_identifier", d.Id())
+	d.Set("state", out.State)
+
+	return nil
+}
+
+func resourceAuthPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	log.Printf("[INFO] Deleting VPCLattice AuthPolicy: %s", d.Id())
+	_, err := conn.DeleteAuthPolicy(ctx, &vpclattice.DeleteAuthPolicyInput{
+		ResourceIdentifier: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameAuthPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findAuthPolicyByID(ctx context.Context, conn *vpclattice.Client, id string) (*vpclattice.GetAuthPolicyOutput, error) {
+	in := &vpclattice.GetAuthPolicyInput{
+		ResourceIdentifier: aws.String(id),
+	}
+	out, err := conn.GetAuthPolicy(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	// A resource without an auth policy returns an empty policy rather than an error.
+	if out == nil || out.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,166 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCLatticeAuthPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var policy vpclattice.GetAuthPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_auth_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAuthPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthPolicyConfig_basic(rName, "vpc-lattice-svcs:Invoke"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthPolicyExists(ctx, resourceName, &policy),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"vpc-lattice-svcs:Invoke"`)),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_identifier", "aws_vpclattice_service.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAuthPolicyConfig_basic(rName, "vpc-lattice-svcs:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthPolicyExists(ctx, resourceName, &policy),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"vpc-lattice-svcs:\*"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeAuthPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var policy vpclattice.GetAuthPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_auth_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAuthPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthPolicyConfig_basic(rName, "vpc-lattice-svcs:Invoke"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfvpclattice.ResourceAuthPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuthPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpclattice_auth_policy" {
+				continue
+			}
+
+			_, err := tfvpclattice.FindAuthPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Lattice Auth Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAuthPolicyExists(ctx context.Context, n string, v *vpclattice.GetAuthPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Auth Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeClient()
+
+		output, err := tfvpclattice.FindAuthPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAuthPolicyConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpclattice_service" "test" {
+  name      = %[1]q
+  auth_type = "AWS_IAM"
+}
+
+resource "aws_vpclattice_auth_policy" "test" {
+  resource_identifier = aws_vpclattice_service.test.arn
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+      Action   = %[2]q
+      Resource = "*"
+    }]
+  })
+}
+`, rName, action)
+}
@@ -0,0 +1,16 @@
+package vpclattice
+
+// Exports for use in tests only.
+var (
+	FindAccessLogSubscriptionByID            = findAccessLogSubscriptionByID
+	FindAuthPolicyByID                       = findAuthPolicyByID
+	FindListenerByTwoPartKey                 = findListenerByTwoPartKey
+	FindListenerRuleByThreePartKey           = findListenerRuleByThreePartKey
+	FindResourcePolicyByARN                  = findResourcePolicyByARN
+	FindServiceNetworkServiceAssociationByID = findServiceNetworkServiceAssociationByID
+	FindServiceNetworkVPCAssociationByID     = findServiceNetworkVPCAssociationByID
+	FindTargetByThreePartKey                 = findTargetByThreePartKey
+	FindTargetGroupByID                      = findTargetGroupByID
+
+	TargetGroupAttachmentParseResourceID = targetGroupAttachmentParseResourceID
+)
@@ -0,0 +1,358 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_listener", name="Listener")
+// @Tags(identifierAttribute="arn")
+func ResourceListener() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceListenerCreate,
+		ReadWithoutTimeout:   resourceListenerRead,
+		UpdateWithoutTimeout: resourceListenerUpdate,
+		DeleteWithoutTimeout: resourceListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_action": ruleActionSchema(),
+			"listener_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ListenerProtocol](),
+			},
+			"service_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameListener = "Listener"
+
+	listenerIDPartCount = 2
+)
+
+func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	name := d.Get("name").(string)
+	serviceID := d.Get("service_identifier").(string)
+	in := &vpclattice.CreateListenerInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		DefaultAction:     expandRuleAction(d.Get("default_action").([]interface{})[0].(map[string]interface{})),
+		Name:              aws.String(name),
+		Protocol:          types.ListenerProtocol(d.Get("protocol").(string)),
+		ServiceIdentifier: aws.String(serviceID),
+		Tags:              GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		in.Port = aws.Int32(int32(v.(int)))
+	}
+
+	out, err := conn.CreateListener(ctx, in)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameListener, name, err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameListener, name, errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{serviceID, aws.ToString(out.Id)}, listenerIDPartCount)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameListener, name, err)
+	}
+
+	d.SetId(id)
+
+	return resourceListenerRead(ctx, d, meta)
+}
+
+func resourceListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	parts, err := flex.ExpandResourceId(d.Id(), listenerIDPartCount)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameListener, d.Id(), err)
+	}
+
+	serviceID, listenerID := parts[0], parts[1]
+	out, err := findListenerByTwoPartKey(ctx, conn, serviceID, listenerID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice Listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameListener, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	if err := d.Set("default_action", []interface{}{flattenRuleAction(out.DefaultAction)}); err != nil {
+		return diag.Errorf("setting default_action: %s", err)
+	}
+	d.Set("listener_id", out.Id)
+	d.Set("name", out.Name)
+	d.Set("port", out.Port)
+	d.Set("protocol", out.Protocol)
+	d.Set("service_arn", out.ServiceArn)
+	d.Set("service_identifier", serviceID)
+
+	return nil
+}
+
+func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		parts, err := flex.ExpandResourceId(d.Id(), listenerIDPartCount)
+		if err != nil {
+			return create.DiagError(names.VPCLattice, create.ErrActionUpdating, ResNameListener, d.Id(), err)
+		}
+
+		in := &vpclattice.UpdateListenerInput{
+			DefaultAction:      expandRuleAction(d.Get("default_action").([]interface{})[0].(map[string]interface{})),
+			ListenerIdentifier: aws.String(parts[1]),
+			ServiceIdentifier:  aws.String(parts[0]),
+		}
+
+		log.Printf("[DEBUG] Updating VPCLattice Listener (%s): %#v", d.Id(), in)
+		_, err = conn.UpdateListener(ctx, in)
+		if err != nil {
+			return create.DiagError(names.VPCLattice, create.ErrActionUpdating, ResNameListener, d.Id(), err)
+		}
+	}
+
+	return resourceListenerRead(ctx, d, meta)
+}
+
+func resourceListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	parts, err := flex.ExpandResourceId(d.Id(), listenerIDPartCount)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameListener, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting VPCLattice Listener: %s", d.Id())
+	_, err = conn.DeleteListener(ctx, &vpclattice.DeleteListenerInput{
+		ListenerIdentifier: aws.String(parts[1]),
+		ServiceIdentifier:  aws.String(parts[0]),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameListener, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findListenerByTwoPartKey(ctx context.Context, conn *vpclattice.Client, serviceID, listenerID string) (*vpclattice.GetListenerOutput, error) {
+	in := &vpclattice.GetListenerInput{
+		ListenerIdentifier: aws.String(listenerID),
+		ServiceIdentifier:  aws.String(serviceID),
+	}
+	out, err := conn.GetListener(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// ruleActionSchema returns the schema shared by listener default actions and listener rule actions.
+func ruleActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fixed_response": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"status_code": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(100, 599),
+							},
+						},
+					},
+				},
+				"forward": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"target_groups": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"target_group_identifier": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"weight": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      100,
+											ValidateFunc: validation.IntBetween(0, 999),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRuleAction(tfMap map[string]interface{}) types.RuleAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["fixed_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{
+				StatusCode: aws.Int32(int32(tfMap["status_code"].(int))),
+			},
+		}
+	}
+
+	if v, ok := tfMap["forward"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject := types.ForwardAction{}
+
+		for _, v := range tfMap["target_groups"].([]interface{}) {
+			tfMap, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.TargetGroups = append(apiObject.TargetGroups, types.WeightedTargetGroup{
+				TargetGroupIdentifier: aws.String(tfMap["target_group_identifier"].(string)),
+				Weight:                aws.Int32(int32(tfMap["weight"].(int))),
+			})
+		}
+
+		return &types.RuleActionMemberForward{
+			Value: apiObject,
+		}
+	}
+
+	return nil
+}
+
+func flattenRuleAction(apiObject types.RuleAction) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.RuleActionMemberFixedResponse:
+		tfMap["fixed_response"] = []interface{}{map[string]interface{}{
+			"status_code": aws.ToInt32(v.Value.StatusCode),
+		}}
+	case *types.RuleActionMemberForward:
+		var tfList []interface{}
+
+		for _, v := range v.Value.TargetGroups {
+			tfList = append(tfList, map[string]interface{}{
+				"target_group_identifier": aws.ToString(v.TargetGroupIdentifier),
+				"weight":                  aws.ToInt32(v.Weight),
+			})
+		}
+
+		tfMap["forward"] = []interface{}{map[string]interface{}{
+			"target_groups": tfList,
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,471 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_listener_rule", name="ListenerRule")
+// @Tags(identifierAttribute="arn")
+func ResourceListenerRule() *schema.Resource {
+	matchTypeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"contains": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 200),
+					},
+					"exact": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 200),
+					},
+					"prefix": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 200),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceListenerRuleCreate,
+		ReadWithoutTimeout:   resourceListenerRuleRead,
+		UpdateWithoutTimeout: resourceListenerRuleUpdate,
+		DeleteWithoutTimeout: resourceListenerRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": ruleActionSchema(),
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"listener_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"match": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_match": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_matches": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 5,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"case_sensitive": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"match": matchTypeSchema(),
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"method": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"CONNECT", "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT", "TRACE"}, false),
+									},
+									"path_match": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"case_sensitive": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"match": matchTypeSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameListenerRule = "Listener Rule"
+
+	listenerRuleIDPartCount = 3
+)
+
+func resourceListenerRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	name := d.Get("name").(string)
+	serviceID := d.Get("service_identifier").(string)
+	listenerID := d.Get("listener_identifier").(string)
+	in := &vpclattice.CreateRuleInput{
+		Action:             expandRuleAction(d.Get("action").([]interface{})[0].(map[string]interface{})),
+		ClientToken:        aws.String(id.UniqueId()),
+		ListenerIdentifier: aws.String(listenerID),
+		Match:              expandRuleMatch(d.Get("match").([]interface{})[0].(map[string]interface{})),
+		Name:               aws.String(name),
+		Priority:           aws.Int32(int32(d.Get("priority").(int))),
+		ServiceIdentifier:  aws.String(serviceID),
+		Tags:               GetTagsIn(ctx),
+	}
+
+	out, err := conn.CreateRule(ctx, in)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameListenerRule, name, err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameListenerRule, name, errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{serviceID, listenerID, aws.ToString(out.Id)}, listenerRuleIDPartCount)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameListenerRule, name, err)
+	}
+
+	d.SetId(id)
+
+	return resourceListenerRuleRead(ctx, d, meta)
+}
+
+func resourceListenerRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	parts, err := flex.ExpandResourceId(d.Id(), listenerRuleIDPartCount)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameListenerRule, d.Id(), err)
+	}
+
+	serviceID, listenerID, ruleID := parts[0], parts[1], parts[2]
+	out, err := findListenerRuleByThreePartKey(ctx, conn, serviceID, listenerID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice ListenerRule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameListenerRule, d.Id(), err)
+	}
+
+	if err := d.Set("action", []interface{}{flattenRuleAction(out.Action)}); err != nil {
+		return diag.Errorf("setting action: %s", err)
+	}
+	d.Set("arn", out.Arn)
+	d.Set("listener_identifier", listenerID)
+	if err := d.Set("match", []interface{}{flattenRuleMatch(out.Match)}); err != nil {
+		return diag.Errorf("setting match: %s", err)
+	}
+	d.Set("name", out.Name)
+	d.Set("priority", out.Priority)
+	d.Set("rule_id", out.Id)
+	d.Set("service_identifier", serviceID)
+
+	return nil
+}
+
+func resourceListenerRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		parts, err := flex.ExpandResourceId(d.Id(), listenerRuleIDPartCount)
+		if err != nil {
+			return create.DiagError(names.VPCLattice, create.ErrActionUpdating, ResNameListenerRule, d.Id(), err)
+		}
+
+		in := &vpclattice.UpdateRuleInput{
+			ListenerIdentifier: aws.String(parts[1]),
+			RuleIdentifier:     aws.String(parts[2]),
+			ServiceIdentifier:  aws.String(parts[0]),
+		}
+
+		if d.HasChange("action") {
+			in.Action = expandRuleAction(d.Get("action").([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange("match") {
+			in.Match = expandRuleMatch(d.Get("match").([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange("priority") {
+			in.Priority = aws.Int32(int32(d.Get("priority").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating VPCLattice ListenerRule (%s): %#v", d.Id(), in)
+		_, err = conn.UpdateRule(ctx, in)
+		if err != nil {
+			return create.DiagError(names.VPCLattice, create.ErrActionUpdating, ResNameListenerRule, d.Id(), err)
+		}
+	}
+
+	return resourceListenerRuleRead(ctx, d, meta)
+}
+
+func resourceListenerRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	parts, err := flex.ExpandResourceId(d.Id(), listenerRuleIDPartCount)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameListenerRule, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting VPCLattice ListenerRule: %s", d.Id())
+	_, err = conn.DeleteRule(ctx, &vpclattice.DeleteRuleInput{
+		ListenerIdentifier: aws.String(parts[1]),
+		RuleIdentifier:     aws.String(parts[2]),
+		ServiceIdentifier:  aws.String(parts[0]),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameListenerRule, d.Id(), err)
+	}
+
+	return nil
+}
+
+func findListenerRuleByThreePartKey(ctx context.Context, conn *vpclattice.Client, serviceID, listenerID, ruleID string) (*vpclattice.GetRuleOutput, error) {
+	in := &vpclattice.GetRuleInput{
+		ListenerIdentifier: aws.String(listenerID),
+		RuleIdentifier:     aws.String(ruleID),
+		ServiceIdentifier:  aws.String(serviceID),
+	}
+	out, err := conn.GetRule(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandRuleMatch(tfMap map[string]interface{}) types.RuleMatch {
+	if tfMap == nil {
+		return nil
+	}
+
+	v, ok := tfMap["http_match"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tfMap = v[0].(map[string]interface{})
+	apiObject := types.HttpMatch{}
+
+	for _, v := range tfMap["header_matches"].([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		headerMatch := types.HeaderMatch{
+			CaseSensitive: aws.Bool(tfMap["case_sensitive"].(bool)),
+			Name:          aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["match"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			headerMatch.Match = expandHeaderMatchType(v[0].(map[string]interface{}))
+		}
+
+		apiObject.HeaderMatches = append(apiObject.HeaderMatches, headerMatch)
+	}
+
+	if v, ok := tfMap["method"].(string); ok && v != "" {
+		apiObject.Method = aws.String(v)
+	}
+
+	if v, ok := tfMap["path_match"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		pathMatch := &types.PathMatch{
+			CaseSensitive: aws.Bool(tfMap["case_sensitive"].(bool)),
+		}
+
+		if v, ok := tfMap["match"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			pathMatch.Match = expandPathMatchType(v[0].(map[string]interface{}))
+		}
+
+		apiObject.PathMatch = pathMatch
+	}
+
+	return &types.RuleMatchMemberHttpMatch{
+		Value: apiObject,
+	}
+}
+
+func expandHeaderMatchType(tfMap map[string]interface{}) types.HeaderMatchType {
+	if v, ok := tfMap["contains"].(string); ok && v != "" {
+		return &types.HeaderMatchTypeMemberContains{Value: v}
+	}
+
+	if v, ok := tfMap["exact"].(string); ok && v != "" {
+		return &types.HeaderMatchTypeMemberExact{Value: v}
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		return &types.HeaderMatchTypeMemberPrefix{Value: v}
+	}
+
+	return nil
+}
+
+func expandPathMatchType(tfMap map[string]interface{}) types.PathMatchType {
+	if v, ok := tfMap["exact"].(string); ok && v != "" {
+		return &types.PathMatchTypeMemberExact{Value: v}
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		return &types.PathMatchTypeMemberPrefix{Value: v}
+	}
+
+	return nil
+}
+
+func flattenRuleMatch(apiObject types.RuleMatch) map[string]interface{} {
+	v, ok := apiObject.(*types.RuleMatchMemberHttpMatch)
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := v.Value.HeaderMatches; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, v := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"case_sensitive": aws.ToBool(v.CaseSensitive),
+				"match":          []interface{}{flattenHeaderMatchType(v.Match)},
+				"name":           aws.ToString(v.Name),
+			})
+		}
+
+		tfMap["header_matches"] = tfList
+	}
+
+	if v := v.Value.Method; v != nil {
+		tfMap["method"] = aws.ToString(v)
+	}
+
+	if v := v.Value.PathMatch; v != nil {
+		tfMap["path_match"] = []interface{}{map[string]interface{}{
+			"case_sensitive": aws.ToBool(v.CaseSensitive),
+			"match":          []interface{}{flattenPathMatchType(v.Match)},
+		}}
+	}
+
+	return map[string]interface{}{
+		"http_match": []interface{}{tfMap},
+	}
+}
+
+func flattenHeaderMatchType(apiObject types.HeaderMatchType) map[string]interface{} {
+	switch v := apiObject.(type) {
+	case *types.HeaderMatchTypeMemberContains:
+		return map[string]interface{}{"contains": v.Value}
+	case *types.HeaderMatchTypeMemberExact:
+		return map[string]interface{}{"exact": v.Value}
+	case *types.HeaderMatchTypeMemberPrefix:
+		return map[string]interface{}{"prefix": v.Value}
+	}
+
+	return nil
+}
+
+func flattenPathMatchType(apiObject types.PathMatchType) map[string]interface{} {
+	switch v := apiObject.(type) {
+	case *types.PathMatchTypeMemberExact:
+		return map[string]interface{}{"exact": v.Value}
+	case *types.PathMatchTypeMemberPrefix:
+		return map[string]interface{}{"prefix": v.Value}
+	}
+
+	return nil
+}
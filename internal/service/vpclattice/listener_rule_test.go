@@ -0,0 +1,297 @@
+package vpclattice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCLatticeListenerRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var rule vpclattice.GetRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.fixed_response.0.status_code", "403"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "vpc-lattice", regexp.MustCompile("service/.+/listener/.+/rule/.+$")),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_identifier", "aws_vpclattice_listener.test", "listener_id"),
+					resource.TestCheckResourceAttr(resourceName, "match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.path_match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.path_match.0.case_sensitive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.path_match.0.match.0.prefix", "/admin"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_identifier", "aws_vpclattice_service.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeListenerRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var rule vpclattice.GetRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, resourceName, &rule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfvpclattice.ResourceListenerRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeListenerRule_weightedForward(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var rule vpclattice.GetRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig_weightedForward(rName, 20, "GET", "beta"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "action.0.forward.0.target_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.forward.0.target_groups.0.weight", "90"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.forward.0.target_groups.1.weight", "10"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.header_matches.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.header_matches.0.case_sensitive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.header_matches.0.match.0.exact", "beta"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.header_matches.0.name", "x-release"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccListenerRuleConfig_weightedForward(rName, 30, "POST", "canary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.header_matches.0.match.0.exact", "canary"),
+					resource.TestCheckResourceAttr(resourceName, "match.0.http_match.0.method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckListenerRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpclattice_listener_rule" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfvpclattice.FindListenerRuleByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Lattice Listener Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckListenerRuleExists(ctx context.Context, n string, v *vpclattice.GetRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Lattice Listener Rule ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeClient()
+
+		output, err := tfvpclattice.FindListenerRuleByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccListenerRuleConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccListenerConfig_fixedResponse(rName, 404), testAccTargetGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpclattice_target_group" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+  type = "INSTANCE"
+
+  config {
+    port           = 80
+    protocol       = "HTTP"
+    vpc_identifier = aws_vpc.test.id
+  }
+}
+`, rName))
+}
+
+func testAccListenerRuleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener_rule" "test" {
+  name                = %[1]q
+  listener_identifier = aws_vpclattice_listener.test.listener_id
+  service_identifier  = aws_vpclattice_service.test.id
+  priority            = 10
+
+  match {
+    http_match {
+      path_match {
+        match {
+          prefix = "/admin"
+        }
+      }
+    }
+  }
+
+  action {
+    fixed_response {
+      status_code = 403
+    }
+  }
+}
+`, rName))
+}
+
+func testAccListenerRuleConfig_weightedForward(rName string, priority int, method, headerValue string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpclattice_listener_rule" "test" {
+  name                = %[1]q
+  listener_identifier = aws_vpclattice_listener.test.listener_id
+  service_identifier  = aws_vpclattice_service.test.id
+  priority            = %[2]d
+
+  match {
+    http_match {
+      method = %[3]q
+
+      header_matches {
+        name = "x-release"
+
+        match {
+          exact = %[4]q
+        }
+      }
+
+      path_match {
+        case_sensitive = false
+
+        match {
+          exact = "/api"
+        }
+      }
+    }
+  }
+
+  action {
+    forward {
+      target_groups {
+        target_group_identifier = aws_vpclattice_target_group.test[0].id
+        weight                  = 90
+      }
+
+      target_groups {
+        target_group_identifier = aws_vpclattice_target_group.test[1].id
+        weight                  = 10
+      }
+    }
+  }
+}
+`, rName, priority, method, headerValue))
+}
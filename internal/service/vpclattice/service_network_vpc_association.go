@@ -0,0 +1,292 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_service_network_vpc_association", name="ServiceNetworkVPCAssociation")
+// @Tags(identifierAttribute="arn")
+func ResourceServiceNetworkVPCAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceNetworkVPCAssociationCreate,
+		ReadWithoutTimeout:   resourceServiceNetworkVPCAssociationRead,
+		UpdateWithoutTimeout: resourceServiceNetworkVPCAssociationUpdate,
+		DeleteWithoutTimeout: resourceServiceNetworkVPCAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_network_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameServiceNetworkVPCAssociation = "Service Network VPC Association"
+)
+
+func resourceServiceNetworkVPCAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	in := &vpclattice.CreateServiceNetworkVpcAssociationInput{
+		ClientToken:              aws.String(id.UniqueId()),
+		ServiceNetworkIdentifier: aws.String(d.Get("service_network_identifier").(string)),
+		Tags:                     GetTagsIn(ctx),
+		VpcIdentifier:            aws.String(d.Get("vpc_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		in.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	out, err := conn.CreateServiceNetworkVpcAssociation(ctx, in)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameServiceNetworkVPCAssociation, d.Get("vpc_identifier").(string), err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameServiceNetworkVPCAssociation, d.Get("vpc_identifier").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Id))
+
+	if _, err := waitServiceNetworkVPCAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameServiceNetworkVPCAssociation, d.Id(), err)
+	}
+
+	return resourceServiceNetworkVPCAssociationRead(ctx, d, meta)
+}
+
+func resourceServiceNetworkVPCAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	out, err := findServiceNetworkVPCAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice ServiceNetworkVPCAssociation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameServiceNetworkVPCAssociation, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("created_by", out.CreatedBy)
+	d.Set("security_group_ids", out.SecurityGroupIds)
+	if v := d.Get("service_network_identifier").(string); v != aws.ToString(out.ServiceNetworkArn) {
+		d.Set("service_network_identifier", out.ServiceNetworkId)
+	}
+	d.Set("status", out.Status)
+	d.Set("vpc_identifier", out.VpcId)
+
+	return nil
+}
+
+func resourceServiceNetworkVPCAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	if d.HasChange("security_group_ids") {
+		in := &vpclattice.UpdateServiceNetworkVpcAssociationInput{
+			SecurityGroupIds:                       flex.ExpandStringValueSet(d.Get("security_group_ids").(*schema.Set)),
+			ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating VPCLattice ServiceNetworkVPCAssociation (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateServiceNetworkVpcAssociation(ctx, in)
+		if err != nil {
+			return create.DiagError(names.VPCLattice, create.ErrActionUpdating, ResNameServiceNetworkVPCAssociation, d.Id(), err)
+		}
+
+		if _, err := waitServiceNetworkVPCAssociationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.VPCLattice, create.ErrActionWaitingForUpdate, ResNameServiceNetworkVPCAssociation, d.Id(), err)
+		}
+	}
+
+	return resourceServiceNetworkVPCAssociationRead(ctx, d, meta)
+}
+
+func resourceServiceNetworkVPCAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	log.Printf("[INFO] Deleting VPCLattice ServiceNetworkVPCAssociation: %s", d.Id())
+	_, err := conn.DeleteServiceNetworkVpcAssociation(ctx, &vpclattice.DeleteServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameServiceNetworkVPCAssociation, d.Id(), err)
+	}
+
+	if _, err := waitServiceNetworkVPCAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameServiceNetworkVPCAssociation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusCreateInProgress),
+		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
+		Refresh:                   statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		if out.Status == types.ServiceNetworkVpcAssociationStatusCreateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationUpdated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusUpdateInProgress),
+		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
+		Refresh:                   statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		if out.Status == types.ServiceNetworkVpcAssociationStatusUpdateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ServiceNetworkVpcAssociationStatusDeleteInProgress, types.ServiceNetworkVpcAssociationStatusActive),
+		Target:  []string{},
+		Refresh: statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		if out.Status == types.ServiceNetworkVpcAssociationStatusDeleteFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusServiceNetworkVPCAssociation(ctx context.Context, conn *vpclattice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findServiceNetworkVPCAssociationByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findServiceNetworkVPCAssociationByID(ctx context.Context, conn *vpclattice.Client, id string) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	in := &vpclattice.GetServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String(id),
+	}
+	out, err := conn.GetServiceNetworkVpcAssociation(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -30,6 +30,40 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAccessLogSubscription,
+			TypeName: "aws_vpclattice_access_log_subscription",
+			Name:     "AccessLogSubscription",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceAuthPolicy,
+			TypeName: "aws_vpclattice_auth_policy",
+			Name:     "AuthPolicy",
+		},
+		{
+			Factory:  ResourceListener,
+			TypeName: "aws_vpclattice_listener",
+			Name:     "Listener",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceListenerRule,
+			TypeName: "aws_vpclattice_listener_rule",
+			Name:     "ListenerRule",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceResourcePolicy,
+			TypeName: "aws_vpclattice_resource_policy",
+			Name:     "ResourcePolicy",
+		},
 		{
 			Factory:  ResourceService,
 			TypeName: "aws_vpclattice_service",
@@ -46,6 +80,35 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceServiceNetworkServiceAssociation,
+			TypeName: "aws_vpclattice_service_network_service_association",
+			Name:     "ServiceNetworkServiceAssociation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceServiceNetworkVPCAssociation,
+			TypeName: "aws_vpclattice_service_network_vpc_association",
+			Name:     "ServiceNetworkVPCAssociation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceTargetGroup,
+			TypeName: "aws_vpclattice_target_group",
+			Name:     "TargetGroup",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceTargetGroupAttachment,
+			TypeName: "aws_vpclattice_target_group_attachment",
+			Name:     "TargetGroupAttachment",
+		},
 	}
 }
 
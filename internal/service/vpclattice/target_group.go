@@ -0,0 +1,541 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_target_group", name="TargetGroup")
+// @Tags(identifierAttribute="arn")
+func ResourceTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTargetGroupCreate,
+		ReadWithoutTimeout:   resourceTargetGroupRead,
+		UpdateWithoutTimeout: resourceTargetGroupUpdate,
+		DeleteWithoutTimeout: resourceTargetGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"health_check": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"health_check_interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"health_check_timeout_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 120),
+									},
+									"healthy_threshold_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+									"matcher": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"value": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"protocol": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.TargetGroupProtocol](),
+									},
+									"protocol_version": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.HealthCheckProtocolVersion](),
+									},
+									"unhealthy_threshold_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(2, 10),
+									},
+								},
+							},
+						},
+						"ip_address_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.IpAddressType](),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.TargetGroupProtocol](),
+						},
+						"protocol_version": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.TargetGroupProtocolVersion](),
+						},
+						"vpc_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.TargetGroupType](),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameTargetGroup = "Target Group"
+)
+
+func resourceTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	name := d.Get("name").(string)
+	in := &vpclattice.CreateTargetGroupInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Name:        aws.String(name),
+		Tags:        GetTagsIn(ctx),
+		Type:        types.TargetGroupType(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.Config = expandTargetGroupConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	out, err := conn.CreateTargetGroup(ctx, in)
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameTargetGroup, name, err)
+	}
+
+	if out == nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameTargetGroup, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Id))
+
+	if _, err := waitTargetGroupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameTargetGroup, d.Id(), err)
+	}
+
+	return resourceTargetGroupRead(ctx, d, meta)
+}
+
+func resourceTargetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	out, err := findTargetGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice TargetGroup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameTargetGroup, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	if out.Config != nil {
+		if err := d.Set("config", []interface{}{flattenTargetGroupConfig(out.Config)}); err != nil {
+			return diag.Errorf("setting config: %s", err)
+		}
+	} else {
+		d.Set("config", nil)
+	}
+	d.Set("name", out.Name)
+	d.Set("status", out.Status)
+	d.Set("type", out.Type)
+
+	return nil
+}
+
+func resourceTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	if d.HasChange("config.0.health_check") {
+		in := &vpclattice.UpdateTargetGroupInput{
+			TargetGroupIdentifier: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("config.0.health_check"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.HealthCheck = expandHealthCheckConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating VPCLattice TargetGroup (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateTargetGroup(ctx, in)
+		if err != nil {
+			return create.DiagError(names.VPCLattice, create.ErrActionUpdating, ResNameTargetGroup, d.Id(), err)
+		}
+	}
+
+	return resourceTargetGroupRead(ctx, d, meta)
+}
+
+func resourceTargetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	log.Printf("[INFO] Deleting VPCLattice TargetGroup: %s", d.Id())
+	_, err := conn.DeleteTargetGroup(ctx, &vpclattice.DeleteTargetGroupInput{
+		TargetGroupIdentifier: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameTargetGroup, d.Id(), err)
+	}
+
+	if _, err := waitTargetGroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameTargetGroup, d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.TargetGroupStatusCreateInProgress),
+		Target:                    enum.Slice(types.TargetGroupStatusActive),
+		Refresh:                   statusTargetGroup(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		if out.Status == types.TargetGroupStatusCreateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitTargetGroupDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.TargetGroupStatusDeleteInProgress, types.TargetGroupStatusActive),
+		Target:  []string{},
+		Refresh: statusTargetGroup(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		if out.Status == types.TargetGroupStatusDeleteFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusTargetGroup(ctx context.Context, conn *vpclattice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findTargetGroupByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findTargetGroupByID(ctx context.Context, conn *vpclattice.Client, id string) (*vpclattice.GetTargetGroupOutput, error) {
+	in := &vpclattice.GetTargetGroupInput{
+		TargetGroupIdentifier: aws.String(id),
+	}
+	out, err := conn.GetTargetGroup(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandTargetGroupConfig(tfMap map[string]interface{}) *types.TargetGroupConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.TargetGroupConfig{}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandHealthCheckConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ip_address_type"].(string); ok && v != "" {
+		apiObject.IpAddressType = types.IpAddressType(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = types.TargetGroupProtocol(v)
+	}
+
+	if v, ok := tfMap["protocol_version"].(string); ok && v != "" {
+		apiObject.ProtocolVersion = types.TargetGroupProtocolVersion(v)
+	}
+
+	if v, ok := tfMap["vpc_identifier"].(string); ok && v != "" {
+		apiObject.VpcIdentifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHealthCheckConfig(tfMap map[string]interface{}) *types.HealthCheckConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.HealthCheckConfig{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["health_check_interval_seconds"].(int); ok && v != 0 {
+		apiObject.HealthCheckIntervalSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["health_check_timeout_seconds"].(int); ok && v != 0 {
+		apiObject.HealthCheckTimeoutSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["healthy_threshold_count"].(int); ok && v != 0 {
+		apiObject.HealthyThresholdCount = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["matcher"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["value"].(string); ok && v != "" {
+			apiObject.Matcher = &types.MatcherMemberHttpCode{Value: v}
+		}
+	}
+
+	if v, ok := tfMap["path"].(string); ok && v != "" {
+		apiObject.Path = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = types.TargetGroupProtocol(v)
+	}
+
+	if v, ok := tfMap["protocol_version"].(string); ok && v != "" {
+		apiObject.ProtocolVersion = types.HealthCheckProtocolVersion(v)
+	}
+
+	if v, ok := tfMap["unhealthy_threshold_count"].(int); ok && v != 0 {
+		apiObject.UnhealthyThresholdCount = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenTargetGroupConfig(apiObject *types.TargetGroupConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ip_address_type":  string(apiObject.IpAddressType),
+		"protocol":         string(apiObject.Protocol),
+		"protocol_version": string(apiObject.ProtocolVersion),
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{flattenHealthCheckConfig(v)}
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.VpcIdentifier; v != nil {
+		tfMap["vpc_identifier"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenHealthCheckConfig(apiObject *types.HealthCheckConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"protocol":         string(apiObject.Protocol),
+		"protocol_version": string(apiObject.ProtocolVersion),
+	}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.HealthCheckIntervalSeconds; v != nil {
+		tfMap["health_check_interval_seconds"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.HealthCheckTimeoutSeconds; v != nil {
+		tfMap["health_check_timeout_seconds"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.HealthyThresholdCount; v != nil {
+		tfMap["healthy_threshold_count"] = aws.ToInt32(v)
+	}
+
+	if v, ok := apiObject.Matcher.(*types.MatcherMemberHttpCode); ok {
+		tfMap["matcher"] = []interface{}{map[string]interface{}{
+			"value": v.Value,
+		}}
+	}
+
+	if v := apiObject.Path; v != nil {
+		tfMap["path"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.UnhealthyThresholdCount; v != nil {
+		tfMap["unhealthy_threshold_count"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
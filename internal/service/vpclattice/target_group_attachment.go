@@ -0,0 +1,345 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_target_group_attachment", name="TargetGroupAttachment")
+func ResourceTargetGroupAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTargetGroupAttachmentCreate,
+		ReadWithoutTimeout:   resourceTargetGroupAttachmentRead,
+		DeleteWithoutTimeout: resourceTargetGroupAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"target_group_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameTargetGroupAttachment = "Target Group Attachment"
+)
+
+func resourceTargetGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	targetGroupID := d.Get("target_group_identifier").(string)
+	target := expandTarget(d.Get("target").([]interface{})[0].(map[string]interface{}))
+	id := targetGroupAttachmentCreateResourceID(targetGroupID, aws.ToString(target.Id), aws.ToInt32(target.Port))
+	in := &vpclattice.RegisterTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupID),
+		Targets:               []types.Target{target},
+	}
+
+	out, err := conn.RegisterTargets(ctx, in)
+	if err == nil && out != nil && len(out.Unsuccessful) > 0 {
+		failure := out.Unsuccessful[0]
+		err = fmt.Errorf("%s: %s", aws.ToString(failure.FailureCode), aws.ToString(failure.FailureMessage))
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionCreating, ResNameTargetGroupAttachment, id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitTargetGroupAttachmentCreated(ctx, conn, targetGroupID, aws.ToString(target.Id), aws.ToInt32(target.Port), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	return resourceTargetGroupAttachmentRead(ctx, d, meta)
+}
+
+func resourceTargetGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	targetGroupID, targetID, targetPort, err := targetGroupAttachmentParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	out, err := findTargetByThreePartKey(ctx, conn, targetGroupID, targetID, targetPort)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice TargetGroupAttachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionReading, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	d.Set("status", out.Status)
+	if err := d.Set("target", []interface{}{flattenTargetSummary(out)}); err != nil {
+		return diag.Errorf("setting target: %s", err)
+	}
+	d.Set("target_group_identifier", targetGroupID)
+
+	return nil
+}
+
+func resourceTargetGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VPCLatticeClient()
+
+	targetGroupID, targetID, targetPort, err := targetGroupAttachmentParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	target := types.Target{
+		Id: aws.String(targetID),
+	}
+	if targetPort != 0 {
+		target.Port = aws.Int32(targetPort)
+	}
+
+	log.Printf("[INFO] Deleting VPCLattice TargetGroupAttachment: %s", d.Id())
+	_, err = conn.DeregisterTargets(ctx, &vpclattice.DeregisterTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupID),
+		Targets:               []types.Target{target},
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.VPCLattice, create.ErrActionDeleting, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	if _, err := waitTargetGroupAttachmentDeleted(ctx, conn, targetGroupID, targetID, targetPort, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameTargetGroupAttachment, d.Id(), err)
+	}
+
+	return nil
+}
+
+const targetGroupAttachmentIDPartCount = 3
+
+// The target port is optional (e.g. for Lambda targets), so the ID has either two or three parts.
+func targetGroupAttachmentCreateResourceID(targetGroupID, targetID string, targetPort int32) string {
+	parts := []string{targetGroupID, targetID}
+
+	if targetPort != 0 {
+		parts = append(parts, strconv.Itoa(int(targetPort)))
+	}
+
+	return strings.Join(parts, flex.ResourceIdSeparator)
+}
+
+func targetGroupAttachmentParseResourceID(id string) (string, string, int32, error) {
+	parts := strings.Split(id, flex.ResourceIdSeparator)
+
+	switch {
+	case len(parts) == targetGroupAttachmentIDPartCount-1 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], 0, nil
+	case len(parts) == targetGroupAttachmentIDPartCount && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		port, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return "", "", 0, fmt.Errorf("parsing target port (%s): %w", parts[2], err)
+		}
+
+		return parts[0], parts[1], int32(port), nil
+	}
+
+	return "", "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected TARGET-GROUP-ID%[2]sTARGET-ID or TARGET-GROUP-ID%[2]sTARGET-ID%[2]sPORT", id, flex.ResourceIdSeparator)
+}
+
+func waitTargetGroupAttachmentCreated(ctx context.Context, conn *vpclattice.Client, targetGroupID, targetID string, targetPort int32, timeout time.Duration) (*types.TargetSummary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.TargetStatusInitial),
+		Target:                    enum.Slice(types.TargetStatusHealthy, types.TargetStatusUnhealthy, types.TargetStatusUnused, types.TargetStatusUnavailable),
+		Refresh:                   statusTarget(ctx, conn, targetGroupID, targetID, targetPort),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.TargetSummary); ok {
+		if v := out.ReasonCode; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitTargetGroupAttachmentDeleted(ctx context.Context, conn *vpclattice.Client, targetGroupID, targetID string, targetPort int32, timeout time.Duration) (*types.TargetSummary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.TargetStatusDraining, types.TargetStatusInitial),
+		Target:  []string{},
+		Refresh: statusTarget(ctx, conn, targetGroupID, targetID, targetPort),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.TargetSummary); ok {
+		if v := out.ReasonCode; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusTarget(ctx context.Context, conn *vpclattice.Client, targetGroupID, targetID string, targetPort int32) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findTargetByThreePartKey(ctx, conn, targetGroupID, targetID, targetPort)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findTargetByThreePartKey(ctx context.Context, conn *vpclattice.Client, targetGroupID, targetID string, targetPort int32) (*types.TargetSummary, error) {
+	target := types.Target{
+		Id: aws.String(targetID),
+	}
+	if targetPort != 0 {
+		target.Port = aws.Int32(targetPort)
+	}
+	in := &vpclattice.ListTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupID),
+		Targets:               []types.Target{target},
+	}
+
+	paginator := vpclattice.NewListTargetsPaginator(conn, in)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			var nfe *types.ResourceNotFoundException
+			if errors.As(err, &nfe) {
+				return nil, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			if aws.ToString(v.Id) != targetID {
+				continue
+			}
+
+			if targetPort != 0 && aws.ToInt32(v.Port) != targetPort {
+				continue
+			}
+
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+func expandTarget(tfMap map[string]interface{}) types.Target {
+	apiObject := types.Target{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenTargetSummary(apiObject *types.TargetSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Id; v != nil {
+		tfMap["id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
@@ -20,7 +20,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -28,17 +28,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    sweepRegexMatchSet,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    sweepRuleGroups,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -46,7 +46,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    sweepWebACLs,
 	})
@@ -81,55 +81,59 @@ func sweepRateBasedRules(region string) error {
 			id := aws.StringValue(rule.RuleId)
 			wr := NewRetryer(conn, region)
 
-			_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-				return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
-			})
+			err := sweep.DeleteFunc(ctx, client, "aws_wafregional_rate_based_rule", id, func() error {
+				_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+					deleteInput.ChangeToken = token
+					log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
+					return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
+				})
 
-			if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
-				getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
-					RuleId: rule.RuleId,
-				}
+				if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
+					getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
+						RuleId: rule.RuleId,
+					}
 
-				getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRuleWithContext(ctx, getRateBasedRuleInput)
+					getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRuleWithContext(ctx, getRateBasedRuleInput)
 
-				if getRateBasedRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rate-Based Rule (%s): %s", id, getRateBasedRuleErr)
-				}
+					if getRateBasedRuleErr != nil {
+						return fmt.Errorf("error getting WAF Regional Rate-Based Rule (%s): %s", id, getRateBasedRuleErr)
+					}
 
-				var updates []*waf.RuleUpdate
-				updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
-					RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
-					RuleId:    rule.RuleId,
-					Updates:   updates,
-				}
+					var updates []*waf.RuleUpdate
+					updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
+						RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
+						RuleId:    rule.RuleId,
+						Updates:   updates,
+					}
 
-				for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
+					for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
+						update := &waf.RuleUpdate{
+							Action:    aws.String(waf.ChangeActionDelete),
+							Predicate: predicate,
+						}
+
+						updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
 					}
 
-					updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
-				}
+					_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+						updateRateBasedRuleInput.ChangeToken = token
+						log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
+						return conn.UpdateRateBasedRuleWithContext(ctx, updateRateBasedRuleInput)
+					})
 
-				_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					updateRateBasedRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
-					return conn.UpdateRateBasedRuleWithContext(ctx, updateRateBasedRuleInput)
-				})
+					if updateWebACLErr != nil {
+						return fmt.Errorf("error removing predicates from WAF Regional Rate-Based Rule (%s): %s", id, updateWebACLErr)
+					}
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rate-Based Rule (%s): %s", id, updateWebACLErr)
+					_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+						deleteInput.ChangeToken = token
+						log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
+						return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
+					})
 				}
 
-				_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-					return conn.DeleteRateBasedRuleWithContext(ctx, deleteInput)
-				})
-			}
+				return err
+			})
 
 			if err != nil {
 				return fmt.Errorf("error deleting WAF Regional Rate-Based Rule (%s): %s", id, err)
@@ -170,7 +174,9 @@ func sweepRegexMatchSet(region string) error {
 				continue
 			}
 
-			err = DeleteRegexMatchSetResource(ctx, conn, region, region, id, GetRegexMatchTuplesFromAPIResource(set))
+			err = sweep.DeleteFunc(ctx, client, "aws_wafregional_regex_match_set", id, func() error {
+				return DeleteRegexMatchSetResource(ctx, conn, region, region, id, GetRegexMatchTuplesFromAPIResource(set))
+			})
 			if err != nil {
 				if !tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonexistentItemException) {
 					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting WAF Regional Regex Match Set (%s): %w", id, err))
@@ -224,7 +230,9 @@ func sweepRuleGroups(region string) error {
 			return err
 		}
 		oldRules := tfwaf.FlattenActivatedRules(rResp.ActivatedRules)
-		err = DeleteRuleGroup(ctx, *group.RuleGroupId, oldRules, conn, region)
+		err = sweep.DeleteFunc(ctx, client, "aws_wafregional_rule_group", aws.StringValue(group.RuleGroupId), func() error {
+			return DeleteRuleGroup(ctx, *group.RuleGroupId, oldRules, conn, region)
+		})
 		if err != nil {
 			return err
 		}
@@ -262,54 +270,58 @@ func sweepRules(region string) error {
 			id := aws.StringValue(rule.RuleId)
 			wr := NewRetryer(conn, region)
 
-			_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-				return conn.DeleteRuleWithContext(ctx, deleteInput)
-			})
+			err := sweep.DeleteFunc(ctx, client, "aws_wafregional_rule", id, func() error {
+				_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+					deleteInput.ChangeToken = token
+					log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
+					return conn.DeleteRuleWithContext(ctx, deleteInput)
+				})
 
-			if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
-				getRuleInput := &waf.GetRuleInput{
-					RuleId: rule.RuleId,
-				}
+				if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
+					getRuleInput := &waf.GetRuleInput{
+						RuleId: rule.RuleId,
+					}
 
-				getRuleOutput, getRuleErr := conn.GetRuleWithContext(ctx, getRuleInput)
+					getRuleOutput, getRuleErr := conn.GetRuleWithContext(ctx, getRuleInput)
 
-				if getRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rule (%s): %s", id, getRuleErr)
-				}
+					if getRuleErr != nil {
+						return fmt.Errorf("error getting WAF Regional Rule (%s): %s", id, getRuleErr)
+					}
 
-				var updates []*waf.RuleUpdate
-				updateRuleInput := &waf.UpdateRuleInput{
-					RuleId:  rule.RuleId,
-					Updates: updates,
-				}
+					var updates []*waf.RuleUpdate
+					updateRuleInput := &waf.UpdateRuleInput{
+						RuleId:  rule.RuleId,
+						Updates: updates,
+					}
+
+					for _, predicate := range getRuleOutput.Rule.Predicates {
+						update := &waf.RuleUpdate{
+							Action:    aws.String(waf.ChangeActionDelete),
+							Predicate: predicate,
+						}
 
-				for _, predicate := range getRuleOutput.Rule.Predicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
+						updateRuleInput.Updates = append(updateRuleInput.Updates, update)
 					}
 
-					updateRuleInput.Updates = append(updateRuleInput.Updates, update)
-				}
+					_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+						updateRuleInput.ChangeToken = token
+						log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
+						return conn.UpdateRuleWithContext(ctx, updateRuleInput)
+					})
 
-				_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					updateRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
-					return conn.UpdateRuleWithContext(ctx, updateRuleInput)
-				})
+					if updateWebACLErr != nil {
+						return fmt.Errorf("error removing predicates from WAF Regional Rule (%s): %s", id, updateWebACLErr)
+					}
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rule (%s): %s", id, updateWebACLErr)
+					_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+						deleteInput.ChangeToken = token
+						log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
+						return conn.DeleteRuleWithContext(ctx, deleteInput)
+					})
 				}
 
-				_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-					return conn.DeleteRuleWithContext(ctx, deleteInput)
-				})
-			}
+				return err
+			})
 
 			if err != nil {
 				return fmt.Errorf("error deleting WAF Regional Rule (%s): %s", id, err)
@@ -355,55 +367,59 @@ func sweepWebACLs(region string) error {
 			id := aws.StringValue(webACL.WebACLId)
 			wr := NewRetryer(conn, region)
 
-			_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-				return conn.DeleteWebACLWithContext(ctx, deleteInput)
-			})
+			err := sweep.DeleteFunc(ctx, client, "aws_wafregional_web_acl", id, func() error {
+				_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+					deleteInput.ChangeToken = token
+					log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
+					return conn.DeleteWebACLWithContext(ctx, deleteInput)
+				})
 
-			if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
-				getWebACLInput := &waf.GetWebACLInput{
-					WebACLId: webACL.WebACLId,
-				}
+				if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonEmptyEntityException) {
+					getWebACLInput := &waf.GetWebACLInput{
+						WebACLId: webACL.WebACLId,
+					}
 
-				getWebACLOutput, getWebACLErr := conn.GetWebACLWithContext(ctx, getWebACLInput)
+					getWebACLOutput, getWebACLErr := conn.GetWebACLWithContext(ctx, getWebACLInput)
 
-				if getWebACLErr != nil {
-					return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
-				}
+					if getWebACLErr != nil {
+						return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
+					}
 
-				var updates []*waf.WebACLUpdate
-				updateWebACLInput := &waf.UpdateWebACLInput{
-					DefaultAction: getWebACLOutput.WebACL.DefaultAction,
-					Updates:       updates,
-					WebACLId:      webACL.WebACLId,
-				}
+					var updates []*waf.WebACLUpdate
+					updateWebACLInput := &waf.UpdateWebACLInput{
+						DefaultAction: getWebACLOutput.WebACL.DefaultAction,
+						Updates:       updates,
+						WebACLId:      webACL.WebACLId,
+					}
 
-				for _, rule := range getWebACLOutput.WebACL.Rules {
-					update := &waf.WebACLUpdate{
-						Action:        aws.String(waf.ChangeActionDelete),
-						ActivatedRule: rule,
+					for _, rule := range getWebACLOutput.WebACL.Rules {
+						update := &waf.WebACLUpdate{
+							Action:        aws.String(waf.ChangeActionDelete),
+							ActivatedRule: rule,
+						}
+
+						updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
 					}
 
-					updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
-				}
+					_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+						updateWebACLInput.ChangeToken = token
+						log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
+						return conn.UpdateWebACLWithContext(ctx, updateWebACLInput)
+					})
 
-				_, updateWebACLErr := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					updateWebACLInput.ChangeToken = token
-					log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
-					return conn.UpdateWebACLWithContext(ctx, updateWebACLInput)
-				})
+					if updateWebACLErr != nil {
+						return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
+					}
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
+					_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
+						deleteInput.ChangeToken = token
+						log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
+						return conn.DeleteWebACLWithContext(ctx, deleteInput)
+					})
 				}
 
-				_, err = wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-					return conn.DeleteWebACLWithContext(ctx, deleteInput)
-				})
-			}
+				return err
+			})
 
 			if err != nil {
 				return fmt.Errorf("error deleting WAF Regional Web ACL (%s): %s", id, err)
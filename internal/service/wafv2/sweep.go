@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
 		Name: "aws_wafv2_ip_set",
 		F:    sweepIPSets,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
 		Name: "aws_wafv2_regex_pattern_set",
 		F:    sweepRegexPatternSets,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
 		Name: "aws_wafv2_web_acl",
 		F:    sweepWebACLs,
 	})
@@ -0,0 +1,193 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// attributesFunc returns the attributes of a swept resource used for filtering, or nil if the resource no longer exists.
+type attributesFunc func(context.Context, *Options) (*resourceAttributes, error)
+
+// sweepWithOptions applies the configured sweeper options to a swept resource.
+// The resource is deleted by calling delete unless it is filtered out or this is a dry run.
+func sweepWithOptions(ctx context.Context, meta any, resourceType, id string, attributes attributesFunc, delete func() error) error {
+	opts, err := loadOptions()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.filtering() {
+		attrs, err := attributes(ctx, opts)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping %s (%s): reading attributes: %s", resourceType, id, err)
+			return report(opts, meta, resourceType, id, OutcomeSkipped, "reading attributes failed", err)
+		}
+
+		if attrs == nil {
+			return report(opts, meta, resourceType, id, OutcomeSkipped, "not found", nil)
+		}
+
+		if ok, reason := opts.match(attrs, time.Now()); !ok {
+			return report(opts, meta, resourceType, id, OutcomeSkipped, reason, nil)
+		}
+	}
+
+	if opts.DryRun {
+		return report(opts, meta, resourceType, id, OutcomeDryRun, "", nil)
+	}
+
+	if err := delete(); err != nil {
+		if reportErr := report(opts, meta, resourceType, id, OutcomeFailed, "", err); reportErr != nil {
+			return multierror.Append(err, reportErr)
+		}
+
+		return err
+	}
+
+	return report(opts, meta, resourceType, id, OutcomeDeleted, "", nil)
+}
+
+// sweepUnsupported applies the configured sweeper options to a Sweepable whose attributes cannot be determined.
+// It returns true if the Sweepable must not be deleted.
+func sweepUnsupported(sweepable Sweepable) (bool, error) {
+	opts, err := loadOptions()
+
+	if err != nil {
+		return true, err
+	}
+
+	resourceType := fmt.Sprintf("%T", sweepable)
+
+	if opts.filtering() {
+		return true, report(opts, nil, resourceType, "", OutcomeSkipped, "attributes unavailable", nil)
+	}
+
+	if opts.DryRun {
+		return true, report(opts, nil, resourceType, "", OutcomeDryRun, "", nil)
+	}
+
+	return false, nil
+}
+
+// attributes returns the attributes of a Plugin SDK resource used for filtering.
+// If tags or creation time are filtered on, the resource is first read.
+func (sr *SweepResource) attributes(ctx context.Context, opts *Options) (*resourceAttributes, error) {
+	client, _ := sr.meta.(*conns.AWSClient)
+
+	if client != nil {
+		ctx = tftags.NewContext(ctx, client.DefaultTagsConfig, client.IgnoreTagsConfig)
+	}
+
+	if opts.needsRefresh() {
+		if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+			return nil, err
+		}
+
+		if sr.d.Id() == "" {
+			return nil, nil
+		}
+	}
+
+	attrs := &resourceAttributes{
+		name: sr.d.Id(),
+	}
+
+	if v := sdkStringAttribute(sr.resource, sr.d, names.AttrName); v != "" {
+		attrs.name = v
+	}
+
+	for _, k := range creationTimeAttributeNames {
+		if t, ok := parseCreationTime(sdkStringAttribute(sr.resource, sr.d, k)); ok {
+			attrs.createdAt = t
+			break
+		}
+	}
+
+	if len(opts.Tags) == 0 {
+		return attrs, nil
+	}
+
+	if tagsInContext, ok := tftags.FromContext(ctx); ok && tagsInContext.TagsOut.IsSome() {
+		attrs.tags = tagsInContext.TagsOut.UnwrapOrDefault().IgnoreAWS().Map()
+		return attrs, nil
+	}
+
+	if v, ok := lookupResource(sdkResourceKey(sr.resource)); ok && v.tags != nil {
+		identifier := sr.d.Id()
+		if v.tags.IdentifierAttribute != "id" {
+			identifier = sdkStringAttribute(sr.resource, sr.d, v.tags.IdentifierAttribute)
+		}
+
+		tags, err := listTags(ctx, sr.meta, v, identifier)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attrs.tags = tags
+		return attrs, nil
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := sr.resource.Schema[k]; ok {
+			attrs.tags = tftags.New(ctx, sr.d.Get(k).(map[string]interface{})).IgnoreAWS().Map()
+			break
+		}
+	}
+
+	return attrs, nil
+}
+
+func sdkStringAttribute(r *schema.Resource, d *schema.ResourceData, k string) string {
+	if _, ok := r.Schema[k]; !ok {
+		return ""
+	}
+
+	v, _ := d.Get(k).(string)
+
+	return v
+}
+
+// listTags lists a registered resource's tags using its service package's generic list tags method.
+// ctx must contain tagging information.
+func listTags(ctx context.Context, meta any, v *registeredResource, identifier string) (map[string]string, error) {
+	if identifier == "" {
+		return nil, nil
+	}
+
+	var err error
+
+	if sp, ok := v.servicePackage.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		err = sp.ListTags(ctx, meta, identifier) // Sets tags in Context
+	} else if sp, ok := v.servicePackage.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && v.tags.ResourceType != "" {
+		err = sp.ListTags(ctx, meta, identifier, v.tags.ResourceType) // Sets tags in Context
+	} else {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing tags for %s (%s): %w", v.typeName, identifier, err)
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+
+	if !ok || tagsInContext.TagsOut.IsNone() {
+		return nil, nil
+	}
+
+	return tagsInContext.TagsOut.UnwrapOrDefault().IgnoreAWS().Map(), nil
+}
@@ -11,8 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Terraform Plugin Framework variants of sweeper helpers.
@@ -58,7 +61,21 @@ func NewSweepFrameworkResource(factory func(context.Context) (fwresource.Resourc
 	}
 }
 
+// Delete deletes the resource, subject to any sweeper dry-run and filtering options.
 func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	resourceType := "unknown"
+	if v, ok := lookupResource(funcKey(sr.factory)); ok {
+		resourceType = v.typeName
+	} else if resource, err := sr.factory(ctx); err == nil {
+		resourceType = fwMetadataResponse(ctx, resource).TypeName
+	}
+
+	return sweepWithOptions(ctx, sr.meta, resourceType, sr.id, sr.attributes, func() error {
+		return sr.delete(ctx, timeout, optFns...)
+	})
+}
+
+func (sr *SweepFrameworkResource) delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		err := deleteFrameworkResource(ctx, sr.factory, sr.id, sr.meta, sr.supplementalAttributes)
 
@@ -81,11 +98,144 @@ func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Durat
 	return err
 }
 
-func deleteFrameworkResource(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes []FrameworkSupplementalAttribute) error {
+// attributes returns the attributes of a Terraform Plugin Framework resource used for filtering.
+// If tags or creation time are filtered on, the resource is first read.
+func (sr *SweepFrameworkResource) attributes(ctx context.Context, opts *Options) (*resourceAttributes, error) {
+	attrs := &resourceAttributes{
+		name: sr.id,
+	}
+
+	if !opts.needsRefresh() {
+		return attrs, nil
+	}
+
+	client, _ := sr.meta.(*conns.AWSClient)
+
+	if client != nil {
+		ctx = tftags.NewContext(ctx, client.DefaultTagsConfig, client.IgnoreTagsConfig)
+	}
+
+	resource, state, err := newFrameworkResourceState(ctx, sr.factory, sr.id, sr.meta, sr.supplementalAttributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := response.State.Raw.As(&values); err != nil {
+		return nil, err
+	}
+
+	if v := fwStringAttribute(values, names.AttrName); v != "" {
+		attrs.name = v
+	}
+
+	for _, k := range creationTimeAttributeNames {
+		if t, ok := parseCreationTime(fwStringAttribute(values, k)); ok {
+			attrs.createdAt = t
+			break
+		}
+	}
+
+	if len(opts.Tags) == 0 {
+		return attrs, nil
+	}
+
+	if tagsInContext, ok := tftags.FromContext(ctx); ok && tagsInContext.TagsOut.IsSome() {
+		attrs.tags = tagsInContext.TagsOut.UnwrapOrDefault().IgnoreAWS().Map()
+		return attrs, nil
+	}
+
+	if v, ok := lookupResource(funcKey(sr.factory)); ok && v.tags != nil {
+		tags, err := listTags(ctx, sr.meta, v, fwStringAttribute(values, v.tags.IdentifierAttribute))
+
+		if err != nil {
+			return nil, err
+		}
+
+		attrs.tags = tags
+		return attrs, nil
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if tags, ok := fwStringMapAttribute(values, k); ok {
+			attrs.tags = tftags.New(ctx, tags).IgnoreAWS().Map()
+			break
+		}
+	}
+
+	return attrs, nil
+}
+
+func fwMetadataResponse(ctx context.Context, resource fwresource.Resource) *fwresource.MetadataResponse {
+	response := &fwresource.MetadataResponse{}
+	resource.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, response)
+
+	return response
+}
+
+func fwStringAttribute(values map[string]tftypes.Value, k string) string {
+	v, ok := values[k]
+
+	if !ok || !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	var s string
+
+	if err := v.As(&s); err != nil {
+		return ""
+	}
+
+	return s
+}
+
+func fwStringMapAttribute(values map[string]tftypes.Value, k string) (map[string]string, bool) {
+	v, ok := values[k]
+
+	if !ok || !v.IsKnown() {
+		return nil, false
+	}
+
+	var elements map[string]tftypes.Value
+
+	if err := v.As(&elements); err != nil {
+		return nil, false
+	}
+
+	m := make(map[string]string, len(elements))
+
+	for k, e := range elements {
+		var s string
+
+		if err := e.As(&s); err != nil {
+			return nil, false
+		}
+
+		m[k] = s
+	}
+
+	return m, true
+}
+
+// newFrameworkResourceState returns a configured resource and a Terraform State that contains just the resource ID and any supplemental attributes.
+func newFrameworkResourceState(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes []FrameworkSupplementalAttribute) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
 	resource, err := factory(ctx)
 
 	if err != nil {
-		return err
+		return nil, tfsdk.State{}, err
 	}
 
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: meta}, &fwresource.ConfigureResponse{})
@@ -104,10 +254,20 @@ func deleteFrameworkResource(ctx context.Context, factory func(context.Context)
 	for _, attr := range supplementalAttributes {
 		d := state.SetAttribute(ctx, path.Root(attr.Path), attr.Value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
 	}
 
+	return resource, state, nil
+}
+
+func deleteFrameworkResource(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id string, meta interface{}, supplementalAttributes []FrameworkSupplementalAttribute) error {
+	resource, state, err := newFrameworkResourceState(ctx, factory, id, meta, supplementalAttributes)
+
+	if err != nil {
+		return err
+	}
+
 	response := fwresource.DeleteResponse{}
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
 
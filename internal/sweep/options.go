@@ -0,0 +1,191 @@
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Options control which resources are swept and whether they are deleted.
+type Options struct {
+	// DryRun reports the resources that would be deleted without deleting them.
+	DryRun bool
+	// MinAge is the minimum age of swept resources.
+	MinAge time.Duration
+	// NameRegex is matched against swept resources' names, or IDs for resources without names.
+	NameRegex *regexp.Regexp
+	// ReportFile is the path of a file to which a JSON Lines report of swept resources is appended.
+	ReportFile string
+	// Tags that swept resources must have. A nil value matches any tag value.
+	Tags map[string]*string
+}
+
+var (
+	options     *Options
+	optionsErr  error
+	optionsOnce sync.Once
+)
+
+// loadOptions returns the sweeper options configured via environment variables.
+func loadOptions() (*Options, error) {
+	optionsOnce.Do(func() {
+		options, optionsErr = newOptions(os.Getenv)
+	})
+
+	return options, optionsErr
+}
+
+func newOptions(getenv func(string) string) (*Options, error) {
+	opts := &Options{
+		ReportFile: getenv(envvar.SweepReportFile),
+	}
+
+	if v := getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+
+		opts.DryRun = dryRun
+	}
+
+	if v := getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+
+		opts.MinAge = minAge
+	}
+
+	if v := getenv(envvar.SweepNameRegex); v != "" {
+		re, err := regexp.Compile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepNameRegex, err)
+		}
+
+		opts.NameRegex = re
+	}
+
+	if v := getenv(envvar.SweepFilterTags); v != "" {
+		opts.Tags = make(map[string]*string)
+
+		for _, tag := range strings.Split(v, ",") {
+			key, value, hasValue := strings.Cut(strings.TrimSpace(tag), "=")
+
+			if key == "" {
+				return nil, fmt.Errorf("environment variable %s: empty tag key in %q", envvar.SweepFilterTags, v)
+			}
+
+			if hasValue {
+				value := value
+				opts.Tags[key] = &value
+			} else {
+				opts.Tags[key] = nil
+			}
+		}
+	}
+
+	return opts, nil
+}
+
+// filtering returns whether any resource filters are configured.
+func (o *Options) filtering() bool {
+	return o.MinAge > 0 || o.NameRegex != nil || len(o.Tags) > 0
+}
+
+// needsRefresh returns whether the configured filters need resource attributes that sweepers don't usually set.
+func (o *Options) needsRefresh() bool {
+	return o.MinAge > 0 || len(o.Tags) > 0
+}
+
+// resourceAttributes are the attributes of a resource used to filter sweeping.
+type resourceAttributes struct {
+	// name is the resource's name, or its ID if it has no name.
+	name string
+	// tags are nil if the resource's tags are unknown.
+	tags map[string]string
+	// createdAt is zero if the resource's creation time is unknown.
+	createdAt time.Time
+}
+
+// match returns whether a resource matches the configured filters and if not, why not.
+// Resources whose filtered attributes are unknown never match.
+func (o *Options) match(attrs *resourceAttributes, now time.Time) (bool, string) {
+	if o.NameRegex != nil && !o.NameRegex.MatchString(attrs.name) {
+		return false, fmt.Sprintf("name (%s) does not match %s", attrs.name, o.NameRegex)
+	}
+
+	if o.MinAge > 0 {
+		if attrs.createdAt.IsZero() {
+			return false, "creation time unknown"
+		}
+
+		if age := now.Sub(attrs.createdAt); age < o.MinAge {
+			return false, fmt.Sprintf("age (%s) less than %s", age.Round(time.Second), o.MinAge)
+		}
+	}
+
+	if len(o.Tags) > 0 {
+		if attrs.tags == nil {
+			return false, "tags unknown"
+		}
+
+		keys := make([]string, 0, len(o.Tags))
+		for k := range o.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v, ok := attrs.tags[k]
+
+			if !ok {
+				return false, fmt.Sprintf("tag (%s) not found", k)
+			}
+
+			if want := o.Tags[k]; want != nil && v != *want {
+				return false, fmt.Sprintf("tag (%s) value (%s) does not match %s", k, v, *want)
+			}
+		}
+	}
+
+	return true, ""
+}
+
+var (
+	// creationTimeAttributeNames are the names of resource attributes that commonly hold a resource's creation time.
+	creationTimeAttributeNames = []string{
+		"create_date",
+		"create_time",
+		"created_at",
+		"created_date",
+		"created_time",
+		"created_timestamp",
+		"creation_date",
+		"creation_time",
+		"creation_timestamp",
+		"launch_time",
+	}
+)
+
+// parseCreationTime parses a creation time attribute value.
+func parseCreationTime(v string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, v)
+
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
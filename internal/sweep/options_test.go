@@ -0,0 +1,185 @@
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		env           map[string]string
+		expectedError bool
+		check         func(*testing.T, *Options)
+	}{
+		"empty": {
+			env: map[string]string{},
+			check: func(t *testing.T, opts *Options) {
+				if opts.DryRun || opts.filtering() {
+					t.Errorf("expected no options, got %+v", opts)
+				}
+			},
+		},
+		"all": {
+			env: map[string]string{
+				envvar.SweepDryRun:     "true",
+				envvar.SweepFilterTags: "Owner=team-a, Sandbox",
+				envvar.SweepMinAge:     "2h",
+				envvar.SweepNameRegex:  "^tf-acc-test-",
+				envvar.SweepReportFile: "sweep.jsonl",
+			},
+			check: func(t *testing.T, opts *Options) {
+				if !opts.DryRun {
+					t.Error("expected DryRun")
+				}
+				if got, want := opts.MinAge, 2*time.Hour; got != want {
+					t.Errorf("MinAge = %s, want %s", got, want)
+				}
+				if got, want := opts.NameRegex.String(), "^tf-acc-test-"; got != want {
+					t.Errorf("NameRegex = %s, want %s", got, want)
+				}
+				if got, want := opts.ReportFile, "sweep.jsonl"; got != want {
+					t.Errorf("ReportFile = %s, want %s", got, want)
+				}
+				if v, ok := opts.Tags["Owner"]; !ok || v == nil || *v != "team-a" {
+					t.Errorf("Tags[Owner] = %v, want team-a", v)
+				}
+				if v, ok := opts.Tags["Sandbox"]; !ok || v != nil {
+					t.Errorf("Tags[Sandbox] = %v, want any value", v)
+				}
+			},
+		},
+		"invalid dry run": {
+			env:           map[string]string{envvar.SweepDryRun: "maybe"},
+			expectedError: true,
+		},
+		"invalid min age": {
+			env:           map[string]string{envvar.SweepMinAge: "2 days"},
+			expectedError: true,
+		},
+		"invalid name regex": {
+			env:           map[string]string{envvar.SweepNameRegex: "("},
+			expectedError: true,
+		},
+		"empty tag key": {
+			env:           map[string]string{envvar.SweepFilterTags: "=value"},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := newOptions(func(k string) string {
+				return testCase.env[k]
+			})
+
+			if err == nil && testCase.expectedError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.check != nil {
+				testCase.check(t, opts)
+			}
+		})
+	}
+}
+
+func TestOptionsMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	opts, err := newOptions(func(k string) string {
+		return map[string]string{
+			envvar.SweepFilterTags: "Owner=team-a,Sandbox",
+			envvar.SweepMinAge:     "1h",
+			envvar.SweepNameRegex:  "^tf-acc-test-",
+		}[k]
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		attrs    *resourceAttributes
+		expected bool
+	}{
+		"match": {
+			attrs: &resourceAttributes{
+				name:      "tf-acc-test-1",
+				tags:      map[string]string{"Owner": "team-a", "Sandbox": ""},
+				createdAt: now.Add(-2 * time.Hour),
+			},
+			expected: true,
+		},
+		"name mismatch": {
+			attrs: &resourceAttributes{
+				name:      "production",
+				tags:      map[string]string{"Owner": "team-a", "Sandbox": ""},
+				createdAt: now.Add(-2 * time.Hour),
+			},
+		},
+		"too new": {
+			attrs: &resourceAttributes{
+				name:      "tf-acc-test-1",
+				tags:      map[string]string{"Owner": "team-a", "Sandbox": ""},
+				createdAt: now.Add(-30 * time.Minute),
+			},
+		},
+		"creation time unknown": {
+			attrs: &resourceAttributes{
+				name: "tf-acc-test-1",
+				tags: map[string]string{"Owner": "team-a", "Sandbox": ""},
+			},
+		},
+		"tag value mismatch": {
+			attrs: &resourceAttributes{
+				name:      "tf-acc-test-1",
+				tags:      map[string]string{"Owner": "team-b", "Sandbox": ""},
+				createdAt: now.Add(-2 * time.Hour),
+			},
+		},
+		"tag missing": {
+			attrs: &resourceAttributes{
+				name:      "tf-acc-test-1",
+				tags:      map[string]string{"Owner": "team-a"},
+				createdAt: now.Add(-2 * time.Hour),
+			},
+		},
+		"tags unknown": {
+			attrs: &resourceAttributes{
+				name:      "tf-acc-test-1",
+				createdAt: now.Add(-2 * time.Hour),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := opts.match(testCase.attrs, now)
+
+			if got != testCase.expected {
+				t.Errorf("match = %t (%s), want %t", got, reason, testCase.expected)
+			}
+
+			if !got && reason == "" {
+				t.Error("expected reason")
+			}
+		})
+	}
+}
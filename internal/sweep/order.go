@@ -0,0 +1,188 @@
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// Dependencies on sweepers that are not specified are ignored.
+// Returns an error if a dependency cycle is detected.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	// Add nodes in a stable order so that the overall order is deterministic.
+	names := make([]string, 0, len(sweepers))
+	for name := range sweepers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	return g, nil
+}
+
+// runSweepers runs the specified sweepers in each region in turn.
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, concurrency int, allowFailures bool) error {
+	g, err := sweeperGraph(sweepers)
+
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, region := range regions {
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+
+		err := runSweepersInRegion(region, g, sweepers, concurrency, allowFailures)
+
+		if err != nil {
+			errs = multierror.Append(errs, err)
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// runSweepersInRegion runs sweepers in dependency order, running at most concurrency independent sweepers at a time.
+// Unless allowFailures is set, no further sweepers are started once a sweeper fails.
+func runSweepersInRegion(region string, g *depgraph.Graph, sweepers map[string]*resource.Sweeper, concurrency int, allowFailures bool) error {
+	type result struct {
+		name     string
+		duration time.Duration
+		err      error
+	}
+
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	// pending is the number of incomplete dependencies of each sweeper.
+	pending := make(map[string]int, len(order))
+	ready := make([]string, 0)
+
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+
+		if err != nil {
+			return err
+		}
+
+		pending[name] = len(dependencies)
+
+		if len(dependencies) == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	results := make(chan result)
+	completed := make([]result, 0, len(order))
+	running := 0
+	stop := false
+	var errs *multierror.Error
+
+	for {
+		for len(ready) > 0 && running < concurrency && !stop {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			go func(name string) {
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+				start := time.Now()
+				err := sweepers[name].F(region)
+
+				results <- result{
+					name:     name,
+					duration: time.Since(start),
+					err:      err,
+				}
+			}(name)
+		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-results
+		running--
+		completed = append(completed, r)
+
+		if r.err != nil {
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", r.name, region, r.err)
+			errs = multierror.Append(errs, sweeperError(r.name, region, r.err))
+
+			if !allowFailures {
+				stop = true
+			}
+		}
+
+		dependents, err := g.DirectDependentsOf(r.name)
+
+		if err != nil {
+			return err
+		}
+
+		for _, dependent := range dependents {
+			pending[dependent]--
+
+			if pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	var summary strings.Builder
+
+	for _, r := range completed {
+		status := "ok"
+		if r.err != nil {
+			status = "failed"
+		}
+
+		fmt.Fprintf(&summary, "\t- %s: %s (%s)\n", r.name, status, r.duration)
+	}
+
+	if n := len(order) - len(completed); n > 0 {
+		fmt.Fprintf(&summary, "\t%d sweepers not run\n", n)
+	}
+
+	log.Printf("Sweeper Tests for region (%s) completed:\n%s", region, summary.String())
+
+	return errs.ErrorOrNil()
+}
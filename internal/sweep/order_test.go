@@ -0,0 +1,136 @@
+package sweep
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSweeperGraphCycle(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_c"}},
+		"aws_c": {Name: "aws_c", Dependencies: []string{"aws_a"}},
+		"aws_d": {Name: "aws_d"},
+	}
+
+	_, err := sweeperGraph(sweepers)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := err.Error(), "dependency cycle: aws_a -> aws_b -> aws_c -> aws_a"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want it to contain %q", got, want)
+	}
+}
+
+func TestRunSweepersInRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		concurrency   int
+		allowFailures bool
+		fail          string
+		expectedRun   []string
+		expectedError bool
+	}{
+		"serial": {
+			concurrency: 1,
+			expectedRun: []string{"aws_instance", "aws_network_interface", "aws_security_group", "aws_subnet", "aws_vpc"},
+		},
+		"concurrent": {
+			concurrency: 4,
+			expectedRun: []string{"aws_instance", "aws_network_interface", "aws_security_group", "aws_subnet", "aws_vpc"},
+		},
+		"failure": {
+			concurrency:   1,
+			fail:          "aws_instance",
+			expectedRun:   []string{"aws_instance"},
+			expectedError: true,
+		},
+		"failure allowed": {
+			concurrency:   2,
+			allowFailures: true,
+			fail:          "aws_network_interface",
+			expectedRun:   []string{"aws_instance", "aws_network_interface", "aws_security_group", "aws_subnet", "aws_vpc"},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			run := make([]string, 0)
+
+			sweeper := func(name string, dependencies ...string) *resource.Sweeper {
+				return &resource.Sweeper{
+					Name:         name,
+					Dependencies: dependencies,
+					F: func(region string) error {
+						mu.Lock()
+						defer mu.Unlock()
+
+						// All dependencies must have already run.
+						for _, dependency := range dependencies {
+							found := false
+							for _, v := range run {
+								if v == dependency {
+									found = true
+								}
+							}
+							if !found {
+								t.Errorf("sweeper %s ran before its dependency %s", name, dependency)
+							}
+						}
+
+						run = append(run, name)
+
+						if name == testCase.fail {
+							return errors.New("failed")
+						}
+
+						return nil
+					},
+				}
+			}
+
+			sweepers := map[string]*resource.Sweeper{
+				"aws_instance":          sweeper("aws_instance"),
+				"aws_network_interface": sweeper("aws_network_interface", "aws_instance"),
+				"aws_security_group":    sweeper("aws_security_group", "aws_instance", "aws_network_interface"),
+				"aws_subnet":            sweeper("aws_subnet", "aws_instance", "aws_network_interface"),
+				"aws_vpc":               sweeper("aws_vpc", "aws_security_group", "aws_subnet", "aws_missing"),
+			}
+
+			g, err := sweeperGraph(sweepers)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = runSweepersInRegion("us-west-2", g, sweepers, testCase.concurrency, testCase.allowFailures) //lintignore:AWSAT003
+
+			if err == nil && testCase.expectedError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(run), len(testCase.expectedRun); got != want {
+				t.Errorf("ran %d sweepers (%v), want %d (%v)", got, run, want, testCase.expectedRun)
+			}
+		})
+	}
+}
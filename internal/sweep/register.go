@@ -0,0 +1,121 @@
+package sweep
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const defaultSweeperConcurrency = 4
+
+// sweepers are the registered resource sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a resource sweeper.
+// Sweepers are run in dependency order by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+
+	// Also register with the Terraform Plugin SDK so that resource.TestMain continues to work.
+	resource.AddTestSweepers(name, s)
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise it runs the tests.
+// It honors the Terraform Plugin SDK's -sweep, -sweep-run and -sweep-allow-failures flags.
+//
+// In each region, a sweeper runs only after all of its dependencies have completed and independent sweepers
+// run concurrently, up to the limit set by the TF_AWS_SWEEP_CONCURRENCY environment variable.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	concurrency := defaultSweeperConcurrency
+	if v := os.Getenv(envvar.SweepConcurrency); v != "" {
+		n, err := strconv.Atoi(v)
+
+		if err != nil || n < 1 {
+			log.Printf("[ERR] environment variable %s: expected a positive integer, got %q", envvar.SweepConcurrency, v)
+			os.Exit(1)
+		}
+
+		concurrency = n
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	if err := runSweepers(strings.Split(regions, ","), filterSweepers(flagValue("sweep-run"), sweepers), concurrency, allowFailures); err != nil {
+		log.Printf("[ERR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// flagValue returns the value of the named command-line flag, or "" if the flag is not defined.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated filter strings,
+// along with their transitive dependencies. An empty filter selects all sweepers.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	filtered := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		if _, ok := filtered[name]; ok {
+			return
+		}
+
+		s, ok := source[name]
+
+		if !ok {
+			return
+		}
+
+		filtered[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, f := range strings.Split(filter, ",") {
+			if f != "" && strings.Contains(name, f) {
+				add(name)
+			}
+		}
+	}
+
+	return filtered
+}
+
+func sweeperError(name, region string, err error) error {
+	return fmt.Errorf("running sweeper (%s) in region (%s): %w", name, region, err)
+}
@@ -0,0 +1,109 @@
+package sweep
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// registeredResource is a resource type implemented by a registered service package.
+type registeredResource struct {
+	typeName       string
+	servicePackage conns.ServicePackage
+	tags           *types.ServicePackageResourceTags
+}
+
+var registry = struct {
+	mu sync.RWMutex
+	// resources is keyed by the entry point of a Plugin SDK resource's Delete handler
+	// or of a Terraform Plugin Framework resource's factory.
+	resources map[uintptr]*registeredResource
+}{
+	resources: make(map[uintptr]*registeredResource),
+}
+
+// RegisterServicePackages registers the resources implemented by the specified service packages
+// so that swept resources can be identified by type name in reports and their tags listed for filtering.
+func RegisterServicePackages(ctx context.Context, sps ...conns.ServicePackage) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	for _, sp := range sps {
+		for _, v := range sp.SDKResources(ctx) {
+			if key := sdkResourceKey(v.Factory()); key != 0 {
+				registry.resources[key] = &registeredResource{
+					typeName:       v.TypeName,
+					servicePackage: sp,
+					tags:           v.Tags,
+				}
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				continue
+			}
+
+			response := fwMetadataResponse(ctx, r)
+
+			registry.resources[funcKey(v.Factory)] = &registeredResource{
+				typeName:       response.TypeName,
+				servicePackage: sp,
+				tags:           v.Tags,
+			}
+		}
+	}
+}
+
+func lookupResource(key uintptr) (*registeredResource, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	v, ok := registry.resources[key]
+
+	return v, ok
+}
+
+// sdkResourceKey returns the registry key for a Plugin SDK resource.
+func sdkResourceKey(r *schema.Resource) uintptr {
+	switch {
+	case r.DeleteWithoutTimeout != nil:
+		return funcKey(r.DeleteWithoutTimeout)
+	case r.DeleteContext != nil:
+		return funcKey(r.DeleteContext)
+	case r.Delete != nil:
+		return funcKey(r.Delete)
+	}
+
+	return 0
+}
+
+// sdkResourceTypeName returns a Plugin SDK resource's type name, if registered,
+// or else a name derived from its Delete handler's function name.
+func sdkResourceTypeName(r *schema.Resource) string {
+	key := sdkResourceKey(r)
+
+	if v, ok := lookupResource(key); ok {
+		return v.typeName
+	}
+
+	if f := runtime.FuncForPC(key); f != nil {
+		name := f.Name()
+		// e.g. "github.com/hashicorp/terraform-provider-aws/internal/service/sqs.resourceQueueDelete".
+		return strings.TrimSuffix(name[strings.LastIndex(name, "/")+1:], "Delete")
+	}
+
+	return "unknown"
+}
+
+func funcKey(f any) uintptr {
+	return reflect.ValueOf(f).Pointer()
+}
@@ -0,0 +1,86 @@
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Outcomes of sweeping a resource.
+const (
+	OutcomeDeleted = "deleted"
+	OutcomeDryRun  = "dry-run"
+	OutcomeFailed  = "failed"
+	OutcomeSkipped = "skipped"
+)
+
+// ReportEntry is a line of the JSON Lines sweeper report.
+type ReportEntry struct {
+	ResourceType string    `json:"resource_type"`
+	ID           string    `json:"id"`
+	Region       string    `json:"region"`
+	Outcome      string    `json:"outcome"`
+	Error        string    `json:"error,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+	Time         time.Time `json:"time"`
+}
+
+var reportMu sync.Mutex
+
+// report logs the outcome of sweeping a resource and, if configured, appends it to the report file.
+func report(opts *Options, meta any, resourceType, id, outcome, reason string, err error) error {
+	entry := ReportEntry{
+		ResourceType: resourceType,
+		ID:           id,
+		Outcome:      outcome,
+		Reason:       reason,
+		Time:         time.Now().UTC(),
+	}
+
+	if v, ok := meta.(*conns.AWSClient); ok {
+		entry.Region = v.Region
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	switch outcome {
+	case OutcomeDryRun:
+		log.Printf("[INFO] Dry run: would delete %s (%s) in %s", resourceType, id, entry.Region)
+	case OutcomeSkipped:
+		log.Printf("[DEBUG] Skipping %s (%s) in %s: %s", resourceType, id, entry.Region, reason)
+	}
+
+	if opts.ReportFile == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return fmt.Errorf("encoding sweeper report entry: %w", err)
+	}
+
+	reportMu.Lock()
+	defer reportMu.Unlock()
+
+	f, err := os.OpenFile(opts.ReportFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+
+	if err != nil {
+		return fmt.Errorf("opening sweeper report (%s): %w", opts.ReportFile, err)
+	}
+
+	defer f.Close()
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("writing sweeper report (%s): %w", opts.ReportFile, err)
+	}
+
+	return nil
+}
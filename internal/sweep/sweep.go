@@ -115,9 +115,16 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
+// Delete deletes the resource, subject to any sweeper dry-run and filtering options.
 func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	return sweepWithOptions(ctx, sr.meta, sdkResourceTypeName(sr.resource), sr.d.Id(), sr.attributes, func() error {
+		return sr.delete(ctx, timeout, optFns...)
+	})
+}
+
+func (sr *SweepResource) delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
-		err := DeleteResource(ctx, sr.resource, sr.d, sr.meta)
+		err := deleteResource(ctx, sr.resource, sr.d, sr.meta)
 
 		if err != nil {
 			if strings.Contains(err.Error(), "Throttling") {
@@ -132,7 +139,7 @@ func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	}, optFns...)
 
 	if tfresource.TimedOut(err) {
-		err = DeleteResource(ctx, sr.resource, sr.d, sr.meta)
+		err = deleteResource(ctx, sr.resource, sr.d, sr.meta)
 	}
 
 	return err
@@ -149,6 +156,15 @@ func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, o
 		sweepable := sweepable
 
 		g.Go(func() error {
+			switch sweepable.(type) {
+			case *SweepResource, *SweepFrameworkResource:
+			default:
+				// Custom Sweepables' attributes are unknown so they can't be filtered or reported individually.
+				if skip, err := sweepUnsupported(sweepable); skip {
+					return err
+				}
+			}
+
 			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
 		})
 	}
@@ -232,7 +248,30 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
+// DeleteResource deletes the resource using its Delete handler, subject to any sweeper dry-run and filtering options.
 func DeleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta any) error {
+	sr := NewSweepResource(resource, d, meta)
+
+	return sweepWithOptions(ctx, meta, sdkResourceTypeName(resource), d.Id(), sr.attributes, func() error {
+		return deleteResource(ctx, resource, d, meta)
+	})
+}
+
+// DeleteFunc deletes a resource by calling delete, subject to any sweeper dry-run and filtering options.
+// Sweepers that delete resources by calling AWS APIs directly, rather than via a resource's Delete handler, must do so using DeleteFunc.
+// delete should also wait for the deletion to complete, as it is not called during a dry run.
+// The resource's ID is matched against any name filter. Its tags and creation time are unknown, so it is not deleted if they are filtered on.
+func DeleteFunc(ctx context.Context, meta any, resourceType, id string, delete func() error) error {
+	attributes := func(context.Context, *Options) (*resourceAttributes, error) {
+		return &resourceAttributes{
+			name: id,
+		}, nil
+	}
+
+	return sweepWithOptions(ctx, meta, resourceType, id, attributes, delete)
+}
+
+func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta any) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
@@ -3,150 +3,305 @@
 package sweep_test
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acm"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/amplify"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudsearch"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codegurureviewer"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cur"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dax"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ecrpublic"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/efs"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/events"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/oam"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rum"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
-	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/amplify"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codegurureviewer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecrpublic"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/efs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elasticsearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/oam"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rum"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func TestMain(m *testing.M) {
 	sweep.SweeperClients = make(map[string]interface{})
-	resource.TestMain(m)
+	sweep.RegisterServicePackages(context.Background(),
+		accessanalyzer.ServicePackage,
+		acm.ServicePackage,
+		acmpca.ServicePackage,
+		amplify.ServicePackage,
+		apigateway.ServicePackage,
+		apigatewayv2.ServicePackage,
+		appconfig.ServicePackage,
+		applicationinsights.ServicePackage,
+		appmesh.ServicePackage,
+		apprunner.ServicePackage,
+		appstream.ServicePackage,
+		appsync.ServicePackage,
+		athena.ServicePackage,
+		auditmanager.ServicePackage,
+		autoscaling.ServicePackage,
+		autoscalingplans.ServicePackage,
+		backup.ServicePackage,
+		batch.ServicePackage,
+		budgets.ServicePackage,
+		chimesdkmediapipelines.ServicePackage,
+		cloud9.ServicePackage,
+		cloudformation.ServicePackage,
+		cloudfront.ServicePackage,
+		cloudhsmv2.ServicePackage,
+		cloudsearch.ServicePackage,
+		cloudtrail.ServicePackage,
+		cloudwatch.ServicePackage,
+		codeartifact.ServicePackage,
+		codebuild.ServicePackage,
+		codegurureviewer.ServicePackage,
+		codepipeline.ServicePackage,
+		codestarconnections.ServicePackage,
+		cognitoidp.ServicePackage,
+		configservice.ServicePackage,
+		connect.ServicePackage,
+		cur.ServicePackage,
+		dataexchange.ServicePackage,
+		datasync.ServicePackage,
+		dax.ServicePackage,
+		deploy.ServicePackage,
+		devicefarm.ServicePackage,
+		directconnect.ServicePackage,
+		dlm.ServicePackage,
+		dms.ServicePackage,
+		docdb.ServicePackage,
+		ds.ServicePackage,
+		dynamodb.ServicePackage,
+		ec2.ServicePackage,
+		ecr.ServicePackage,
+		ecrpublic.ServicePackage,
+		ecs.ServicePackage,
+		efs.ServicePackage,
+		eks.ServicePackage,
+		elasticache.ServicePackage,
+		elasticbeanstalk.ServicePackage,
+		elasticsearch.ServicePackage,
+		elb.ServicePackage,
+		elbv2.ServicePackage,
+		emr.ServicePackage,
+		emrcontainers.ServicePackage,
+		emrserverless.ServicePackage,
+		events.ServicePackage,
+		evidently.ServicePackage,
+		firehose.ServicePackage,
+		fis.ServicePackage,
+		fsx.ServicePackage,
+		gamelift.ServicePackage,
+		glacier.ServicePackage,
+		globalaccelerator.ServicePackage,
+		glue.ServicePackage,
+		grafana.ServicePackage,
+		greengrassv2.ServicePackage,
+		guardduty.ServicePackage,
+		healthlake.ServicePackage,
+		iam.ServicePackage,
+		imagebuilder.ServicePackage,
+		iot.ServicePackage,
+		iotanalytics.ServicePackage,
+		iotevents.ServicePackage,
+		kafka.ServicePackage,
+		kafkaconnect.ServicePackage,
+		kendra.ServicePackage,
+		keyspaces.ServicePackage,
+		kinesis.ServicePackage,
+		kinesisanalytics.ServicePackage,
+		kinesisanalyticsv2.ServicePackage,
+		kms.ServicePackage,
+		lambda.ServicePackage,
+		lexmodels.ServicePackage,
+		licensemanager.ServicePackage,
+		lightsail.ServicePackage,
+		location.ServicePackage,
+		logs.ServicePackage,
+		mediaconnect.ServicePackage,
+		medialive.ServicePackage,
+		memorydb.ServicePackage,
+		mq.ServicePackage,
+		mwaa.ServicePackage,
+		neptune.ServicePackage,
+		networkfirewall.ServicePackage,
+		networkmanager.ServicePackage,
+		oam.ServicePackage,
+		opensearch.ServicePackage,
+		opensearchserverless.ServicePackage,
+		opsworks.ServicePackage,
+		pinpoint.ServicePackage,
+		pipes.ServicePackage,
+		qldb.ServicePackage,
+		quicksight.ServicePackage,
+		ram.ServicePackage,
+		rds.ServicePackage,
+		redshift.ServicePackage,
+		redshiftserverless.ServicePackage,
+		resourceexplorer2.ServicePackage,
+		route53.ServicePackage,
+		route53recoverycontrolconfig.ServicePackage,
+		route53resolver.ServicePackage,
+		rum.ServicePackage,
+		s3.ServicePackage,
+		s3control.ServicePackage,
+		sagemaker.ServicePackage,
+		scheduler.ServicePackage,
+		schemas.ServicePackage,
+		secretsmanager.ServicePackage,
+		securitylake.ServicePackage,
+		servicecatalog.ServicePackage,
+		servicediscovery.ServicePackage,
+		ses.ServicePackage,
+		sesv2.ServicePackage,
+		sfn.ServicePackage,
+		simpledb.ServicePackage,
+		sns.ServicePackage,
+		sqs.ServicePackage,
+		ssm.ServicePackage,
+		ssoadmin.ServicePackage,
+		storagegateway.ServicePackage,
+		swf.ServicePackage,
+		synthetics.ServicePackage,
+		timestreamwrite.ServicePackage,
+		transcribe.ServicePackage,
+		transfer.ServicePackage,
+		waf.ServicePackage,
+		wafregional.ServicePackage,
+		wafv2.ServicePackage,
+		workspaces.ServicePackage,
+	)
+	sweep.TestMain(m)
 }
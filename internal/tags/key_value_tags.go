@@ -2,6 +2,7 @@ package tags
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
@@ -33,6 +34,68 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// RequiredConfig contains tags required on all resources.
+type RequiredConfig struct {
+	Keys          []string                  // Tag keys that must be present
+	ValuePatterns map[string]*regexp.Regexp // Patterns that the values of the keyed tags must match, if present
+}
+
+// Validate returns an error if the given tags are missing any required keys
+// or if any tag values do not match the required patterns.
+// Required keys that are ignored by the given configuration are managed outside
+// of Terraform and are not validated.
+func (rc *RequiredConfig) Validate(tags KeyValueTags, ignoreConfig *IgnoreConfig) error {
+	if rc == nil {
+		return nil
+	}
+
+	ignored := func(key string) bool {
+		return len(KeyValueTags{key: nil}.IgnoreConfig(ignoreConfig)) == 0
+	}
+
+	var problems []string
+
+	var missing []string
+	for _, key := range rc.Keys {
+		if ignored(key) {
+			continue
+		}
+
+		if !tags.KeyExists(key) {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		problems = append(problems, fmt.Sprintf("missing required tag keys: %s", strings.Join(missing, ", ")))
+	}
+
+	keys := make([]string, 0, len(rc.ValuePatterns))
+	for key := range rc.ValuePatterns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := tags.KeyValue(key)
+
+		if value == nil || ignored(key) {
+			continue
+		}
+
+		if pattern := rc.ValuePatterns[key]; pattern != nil && !pattern.MatchString(*value) {
+			problems = append(problems, fmt.Sprintf("tag (%s) value (%s) does not match required pattern (%s)", key, *value, pattern))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
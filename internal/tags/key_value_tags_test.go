@@ -2,6 +2,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -313,6 +314,104 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	requiredConfig := &RequiredConfig{
+		Keys: []string{"CostCenter", "Owner"},
+		ValuePatterns: map[string]*regexp.Regexp{
+			"CostCenter": regexp.MustCompile(`^CC-[0-9]+$`),
+		},
+	}
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		ignoreConfig   *IgnoreConfig
+		wantErr        string
+	}{
+		{
+			name: "nil config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "all required tags",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "CC-1234",
+				"Owner":      "team",
+			}),
+			requiredConfig: requiredConfig,
+		},
+		{
+			name: "missing keys",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: requiredConfig,
+			wantErr:        "missing required tag keys: CostCenter, Owner",
+		},
+		{
+			name: "value does not match",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team",
+			}),
+			requiredConfig: requiredConfig,
+			wantErr:        "tag (CostCenter) value (1234) does not match required pattern (^CC-[0-9]+$)",
+		},
+		{
+			name: "missing key and value does not match",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+			}),
+			requiredConfig: requiredConfig,
+			wantErr:        "missing required tag keys: Owner; tag (CostCenter) value (1234) does not match required pattern (^CC-[0-9]+$)",
+		},
+		{
+			name: "ignored key",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "CC-1234",
+			}),
+			requiredConfig: requiredConfig,
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{"Owner"}),
+			},
+		},
+		{
+			name: "ignored key prefix",
+			tags: New(ctx, map[string]string{
+				"Owner": "team",
+			}),
+			requiredConfig: requiredConfig,
+			ignoreConfig: &IgnoreConfig{
+				KeyPrefixes: New(ctx, []string{"Cost"}),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.requiredConfig.Validate(testCase.tags, testCase.ignoreConfig)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error %q, got none", testCase.wantErr)
+			} else if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -148,8 +148,8 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
 		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
 		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasTags:                      !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
@@ -885,10 +885,10 @@ type templateData struct {
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
 	EmitResourceImportState       bool
-	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
+	HasTags                       bool
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool